@@ -4,17 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"math/rand"
 	"net/http"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
 	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
 	"github.com/jacobpq/soccer-manager/internal/handler"
+	"github.com/jacobpq/soccer-manager/internal/jobs"
+	"github.com/jacobpq/soccer-manager/internal/keys"
 	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/mailer"
 	"github.com/jacobpq/soccer-manager/internal/middleware"
 	"github.com/jacobpq/soccer-manager/internal/repository"
 	"github.com/jacobpq/soccer-manager/internal/service"
+	"github.com/jacobpq/soccer-manager/internal/throttle"
+	"github.com/jacobpq/soccer-manager/internal/valuation"
 )
 
 func healthCheckHandler(dbPool *pgxpool.Pool) http.HandlerFunc {
@@ -64,40 +72,200 @@ func main() {
 	teamRepo := repository.NewTeamRepository()
 	playerRepo := repository.NewPlayerRepository()
 	sessionRepo := repository.NewSessionRepository(dbPool)
+	resetRepo := repository.NewPasswordResetRepository(dbPool)
+	recoveryCodeRepo := repository.NewRecoveryCodeRepository(dbPool)
+	idempotencyRepo := repository.NewIdempotencyRepository(dbPool)
+	auctionRepo := repository.NewAuctionRepository()
+	offerRepo := repository.NewOfferRepository()
+	transferRepo := repository.NewTransferRepository()
+	windowRepo := repository.NewWindowRepository(dbPool)
+	loanRepo := repository.NewLoanRepository()
+	swapRepo := repository.NewSwapRepository()
+	watchlistRepo := repository.NewWatchlistRepository()
+
+	keySet, err := keys.Load(cfg)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	go keySet.Watch(context.Background(), cfg.JWTKeysReloadInterval)
+
+	var attemptStore throttle.Store = throttle.NewMemoryStore()
+	if cfg.LoginAttemptStore == "postgres" {
+		attemptStore = repository.NewLoginAttemptRepository(dbPool)
+	}
+	loginLimiter := throttle.NewLimiter(attemptStore, cfg)
+
+	mail, err := mailer.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to init mailer: %v", err)
+	}
+
+	valuationModel, err := valuation.NewModel(cfg, rand.NewSource(time.Now().UnixNano()))
+	if err != nil {
+		log.Fatalf("Failed to init valuation: %v", err)
+	}
 
 	//service
-	authSvc := service.NewAuthService(dbPool, userRepo, teamRepo, playerRepo, sessionRepo, cfg)
+	transferExecutor := service.NewTransferExecutor(playerRepo, teamRepo, transferRepo, valuationModel, cfg)
+	calendarSvc := service.NewCalendarService(windowRepo, cfg)
+	authSvc := service.NewAuthService(dbPool, userRepo, teamRepo, playerRepo, sessionRepo, resetRepo, recoveryCodeRepo, mail, keySet, loginLimiter, cfg)
 	teamSvc := service.NewTeamService(dbPool, teamRepo, playerRepo)
-	transferSvc := service.NewTransferService(dbPool, playerRepo, teamRepo)
+	watchlistSvc := service.NewWatchlistService(dbPool, watchlistRepo, playerRepo, cfg)
+	transferSvc := service.NewTransferService(dbPool, playerRepo, teamRepo, auctionRepo, transferRepo, loanRepo, transferExecutor, calendarSvc, watchlistSvc, cfg)
+	auctionSvc := service.NewAuctionService(dbPool, auctionRepo, playerRepo, teamRepo, loanRepo, transferExecutor, calendarSvc, watchlistSvc, cfg)
+	offerSvc := service.NewOfferService(dbPool, offerRepo, playerRepo, teamRepo, auctionRepo, loanRepo, transferExecutor, calendarSvc, watchlistSvc, cfg)
+	accountSvc := service.NewAccountService(dbPool, userRepo, teamRepo, playerRepo, sessionRepo, transferRepo, loanRepo)
+	swapSvc := service.NewSwapService(dbPool, swapRepo, playerRepo, teamRepo, auctionRepo, offerRepo, loanRepo, transferRepo, calendarSvc, watchlistSvc, cfg)
+	valuationSvc := service.NewValuationService(dbPool, playerRepo, transferRepo, valuationModel, cfg)
+	aiClubSvc, err := service.NewAIClubService(dbPool, teamRepo, playerRepo, transferSvc, rand.NewSource(time.Now().UnixNano()), cfg)
+	if err != nil {
+		log.Fatalf("Failed to init AI clubs: %v", err)
+	}
+	freeAgentSvc := service.NewFreeAgentService(dbPool, playerRepo, teamRepo, auctionRepo, offerRepo, loanRepo, transferRepo, rand.NewSource(time.Now().UnixNano()), cfg)
+	adminSvc := service.NewAdminService(dbPool, userRepo, teamRepo, playerRepo, sessionRepo, loginLimiter)
 
 	//handler
 	authHandler := handler.NewAuthHandler(authSvc)
 	teamHandler := handler.NewTeamHandler(teamSvc)
 	transferHandler := handler.NewTransferHandler(transferSvc)
+	accountHandler := handler.NewAccountHandler(accountSvc)
+	adminHandler := handler.NewAdminHandler(adminSvc)
+	auctionHandler := handler.NewAuctionHandler(auctionSvc)
+	offerHandler := handler.NewOfferHandler(offerSvc)
+	calendarHandler := handler.NewCalendarHandler(calendarSvc)
+	swapHandler := handler.NewSwapHandler(swapSvc)
+	watchlistHandler := handler.NewWatchlistHandler(watchlistSvc)
+	freeAgentHandler := handler.NewFreeAgentHandler(freeAgentSvc)
+	jwksHandler := handler.NewJWKSHandler(keySet)
+
+	//jobs
+	if err := calendarSvc.SeedSeasons(context.Background()); err != nil {
+		log.Fatalf("Failed to seed transfer windows: %v", err)
+	}
+	go jobs.Every(context.Background(), "seed transfer windows", 24*time.Hour, calendarSvc.SeedSeasons)
+	if err := aiClubSvc.EnsureClubs(context.Background()); err != nil {
+		log.Fatalf("Failed to create AI clubs: %v", err)
+	}
+	go jobs.Every(context.Background(), "purge idempotency keys", time.Hour, func(ctx context.Context) error {
+		_, err := idempotencyRepo.DeleteExpired(ctx, time.Now().UTC())
+		return err
+	})
+	go jobs.Every(context.Background(), "settle auctions", cfg.AuctionSettleInterval, auctionSvc.SettleDue)
+	go jobs.Every(context.Background(), "expire listings", cfg.ListingExpireInterval, transferSvc.ExpireListings)
+	go jobs.Every(context.Background(), "expire offers", cfg.OfferExpireInterval, offerSvc.ExpireOffers)
+	go jobs.Every(context.Background(), "return loans", cfg.LoanReturnInterval, transferSvc.ReturnLoans)
+	go jobs.Every(context.Background(), "expire swaps", cfg.SwapExpireInterval, swapSvc.ExpireSwaps)
+	go jobs.Every(context.Background(), "recompute player values", cfg.ValuationInterval, valuationSvc.Recompute)
+	go jobs.Every(context.Background(), "AI club trading", cfg.AIClubInterval, aiClubSvc.Trade)
+	go jobs.Every(context.Background(), "generate free agents", cfg.FreeAgentInterval, freeAgentSvc.Generate)
 
 	//middleware
-	authMiddleware := middleware.Auth(cfg)
+	authMiddleware := middleware.Auth(keySet, sessionRepo)
+	mfaMiddleware := middleware.MFAPending(keySet)
+	verifiedMiddleware := middleware.RequireVerified(cfg, userRepo)
+	moderatorOnly := middleware.RequireRole(models.RoleModerator, models.RoleAdmin)
+	adminOnly := middleware.RequireRole(models.RoleAdmin)
+	idempotent := middleware.Idempotency(idempotencyRepo, cfg.IdempotencyKeyTTL)
 
 	//router
 	mux := http.NewServeMux()
 
 	//util
 	mux.HandleFunc("GET /health", healthCheckHandler(dbPool))
+	mux.HandleFunc("GET /.well-known/jwks.json", api.Make(jwksHandler.GetJWKS))
 
 	//auth
 	mux.HandleFunc("POST /register", api.Make(authHandler.Register))
 	mux.HandleFunc("POST /login", api.Make(authHandler.Login))
+	mux.Handle("POST /login/2fa", mfaMiddleware(api.Make(authHandler.LoginMFA)))
 	mux.HandleFunc("POST /refresh", api.Make(authHandler.Refresh))
+	mux.Handle("POST /logout", authMiddleware(api.Make(authHandler.Logout)))
+	mux.Handle("POST /logout/all", authMiddleware(api.Make(authHandler.LogoutAll)))
+	mux.Handle("GET /sessions", authMiddleware(api.Make(authHandler.ListSessions)))
+	mux.Handle("DELETE /sessions/{id}", authMiddleware(api.Make(authHandler.RevokeSession)))
+	mux.Handle("POST /password/change", authMiddleware(api.Make(authHandler.ChangePassword)))
+	mux.HandleFunc("POST /password/forgot", api.Make(authHandler.ForgotPassword))
+	mux.HandleFunc("POST /password/reset", api.Make(authHandler.ResetPassword))
+	mux.HandleFunc("GET /verify", api.Make(authHandler.VerifyEmail))
+	mux.Handle("POST /verify/resend", authMiddleware(api.Make(authHandler.ResendVerification)))
+	mux.Handle("POST /2fa/enroll", authMiddleware(api.Make(authHandler.EnrollTOTP)))
+	mux.Handle("POST /2fa/confirm", authMiddleware(api.Make(authHandler.ConfirmTOTP)))
+	mux.Handle("POST /2fa/disable", authMiddleware(api.Make(authHandler.DisableTOTP)))
+
+	//account
+	mux.Handle("GET /me/export", authMiddleware(api.Make(accountHandler.Export)))
+	mux.Handle("DELETE /me", authMiddleware(api.Make(accountHandler.Delete)))
 
 	//team
 	mux.Handle("GET /team", authMiddleware(api.Make(teamHandler.GetMyTeam)))
-	mux.Handle("POST /transfer/list", authMiddleware(api.Make(transferHandler.ListPlayer)))
-	mux.Handle("POST /transfer/remove", authMiddleware(api.Make(transferHandler.RemovePlayer)))
-	mux.Handle("GET /transfer/market", authMiddleware(api.Make(transferHandler.GetMarket)))
-	mux.Handle("POST /transfer/buy", authMiddleware(api.Make(transferHandler.BuyPlayer)))
+	mux.Handle("POST /transfer/list", authMiddleware(verifiedMiddleware(idempotent(api.Make(transferHandler.ListPlayer)))))
+	mux.Handle("POST /transfer/remove", authMiddleware(verifiedMiddleware(api.Make(transferHandler.RemovePlayer))))
+	mux.Handle("GET /transfer/market", authMiddleware(verifiedMiddleware(api.Make(transferHandler.GetMarket))))
+	mux.Handle("POST /transfer/buy", authMiddleware(verifiedMiddleware(idempotent(api.Make(transferHandler.BuyPlayer)))))
+	mux.Handle("GET /transfers/latest", authMiddleware(api.Make(transferHandler.GetLatestTransfers)))
+	mux.Handle("GET /players/{id}", authMiddleware(api.Make(transferHandler.GetPlayer)))
+	mux.Handle("GET /players/{id}/history", authMiddleware(api.Make(transferHandler.GetPlayerHistory)))
+	mux.Handle("PUT /players/{id}/release-clause", authMiddleware(verifiedMiddleware(api.Make(transferHandler.SetReleaseClause))))
+	mux.Handle("POST /players/{id}/trigger-release-clause", authMiddleware(verifiedMiddleware(idempotent(api.Make(transferHandler.TriggerReleaseClause)))))
+	mux.Handle("GET /team/transfers", authMiddleware(api.Make(transferHandler.GetTeamTransfers)))
 	mux.Handle("PUT /team", authMiddleware(api.Make(teamHandler.UpdateTeam)))
 	mux.Handle("PUT /player", authMiddleware(api.Make(teamHandler.UpdatePlayer)))
 
+	//calendar
+	mux.Handle("GET /calendar", authMiddleware(api.Make(calendarHandler.GetCalendar)))
+
+	//auctions
+	mux.Handle("GET /auctions", authMiddleware(verifiedMiddleware(api.Make(auctionHandler.GetAuctions))))
+	mux.Handle("GET /auctions/{id}", authMiddleware(verifiedMiddleware(api.Make(auctionHandler.GetAuction))))
+	mux.Handle("POST /auctions", authMiddleware(verifiedMiddleware(idempotent(api.Make(auctionHandler.CreateAuction)))))
+	mux.Handle("POST /auctions/{id}/bids", authMiddleware(verifiedMiddleware(idempotent(api.Make(auctionHandler.PlaceBid)))))
+	mux.Handle("DELETE /auctions/{id}", authMiddleware(verifiedMiddleware(api.Make(auctionHandler.CancelAuction))))
+
+	//offers
+	mux.Handle("POST /offers", authMiddleware(verifiedMiddleware(idempotent(api.Make(offerHandler.CreateOffer)))))
+	mux.Handle("GET /offers/incoming", authMiddleware(verifiedMiddleware(api.Make(offerHandler.GetIncoming))))
+	mux.Handle("GET /offers/outgoing", authMiddleware(verifiedMiddleware(api.Make(offerHandler.GetOutgoing))))
+	mux.Handle("POST /offers/{id}/accept", authMiddleware(verifiedMiddleware(idempotent(api.Make(offerHandler.AcceptOffer)))))
+	mux.Handle("POST /offers/{id}/reject", authMiddleware(verifiedMiddleware(api.Make(offerHandler.RejectOffer))))
+	mux.Handle("POST /offers/{id}/counter", authMiddleware(verifiedMiddleware(idempotent(api.Make(offerHandler.CounterOffer)))))
+
+	//loans
+	mux.Handle("POST /loans", authMiddleware(verifiedMiddleware(idempotent(api.Make(transferHandler.RequestLoan)))))
+	mux.Handle("GET /loans", authMiddleware(verifiedMiddleware(api.Make(transferHandler.GetLoans))))
+	mux.Handle("POST /loans/{id}/accept", authMiddleware(verifiedMiddleware(idempotent(api.Make(transferHandler.AcceptLoan)))))
+	mux.Handle("POST /loans/{id}/reject", authMiddleware(verifiedMiddleware(api.Make(transferHandler.RejectLoan))))
+
+	//swaps
+	mux.Handle("POST /swaps", authMiddleware(verifiedMiddleware(idempotent(api.Make(swapHandler.CreateSwap)))))
+	mux.Handle("GET /swaps", authMiddleware(verifiedMiddleware(api.Make(swapHandler.GetSwaps))))
+	mux.Handle("POST /swaps/{id}/accept", authMiddleware(verifiedMiddleware(idempotent(api.Make(swapHandler.AcceptSwap)))))
+	mux.Handle("POST /swaps/{id}/reject", authMiddleware(verifiedMiddleware(api.Make(swapHandler.RejectSwap))))
+
+	//watchlist
+	mux.Handle("GET /watchlist", authMiddleware(api.Make(watchlistHandler.GetWatchlist)))
+	mux.Handle("POST /watchlist/{playerId}", authMiddleware(api.Make(watchlistHandler.Watch)))
+	mux.Handle("DELETE /watchlist/{playerId}", authMiddleware(api.Make(watchlistHandler.Unwatch)))
+	mux.Handle("GET /notifications", authMiddleware(api.Make(watchlistHandler.GetNotifications)))
+	mux.Handle("POST /notifications/{id}/read", authMiddleware(api.Make(watchlistHandler.MarkNotificationRead)))
+
+	//free agents
+	mux.Handle("GET /free-agents", authMiddleware(verifiedMiddleware(api.Make(freeAgentHandler.GetFreeAgents))))
+	mux.Handle("POST /free-agents/{id}/sign", authMiddleware(verifiedMiddleware(idempotent(api.Make(freeAgentHandler.Sign)))))
+	mux.Handle("POST /players/{id}/release", authMiddleware(verifiedMiddleware(api.Make(freeAgentHandler.Release))))
+
+	//admin
+	mux.Handle("PUT /admin/teams/{id}/budget", authMiddleware(adminOnly(api.Make(adminHandler.AdjustBudget))))
+	mux.Handle("PUT /admin/teams/{id}/name", authMiddleware(moderatorOnly(api.Make(adminHandler.RenameTeam))))
+	mux.Handle("PUT /admin/players/{id}/name", authMiddleware(moderatorOnly(api.Make(adminHandler.RenamePlayer))))
+	mux.Handle("DELETE /admin/market/{id}", authMiddleware(moderatorOnly(api.Make(adminHandler.RemoveListing))))
+	mux.Handle("PUT /admin/users/{id}/role", authMiddleware(adminOnly(api.Make(adminHandler.SetRole))))
+	mux.Handle("POST /admin/users/{id}/ban", authMiddleware(adminOnly(api.Make(adminHandler.BanUser))))
+	mux.Handle("DELETE /admin/users/{id}/ban", authMiddleware(adminOnly(api.Make(adminHandler.UnbanUser))))
+	mux.Handle("POST /admin/users/{id}/unlock", authMiddleware(adminOnly(api.Make(adminHandler.UnlockUser))))
+	mux.Handle("POST /admin/calendar/windows", authMiddleware(adminOnly(api.Make(calendarHandler.CreateWindow))))
+	mux.Handle("POST /admin/calendar/windows/{id}/open", authMiddleware(adminOnly(api.Make(calendarHandler.OpenWindow))))
+	mux.Handle("POST /admin/calendar/windows/{id}/close", authMiddleware(adminOnly(api.Make(calendarHandler.CloseWindow))))
+
 	srv := &http.Server{
 		Addr:    ":" + cfg.Port,
 		Handler: middleware.Logger(middleware.Locale(mux)),
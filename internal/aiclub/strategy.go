@@ -0,0 +1,198 @@
+// Package aiclub decides what the computer-controlled clubs do on the
+// transfer market. It only picks players and prices; carrying the decisions
+// out is left to the same services human managers go through.
+package aiclub
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+// Strategy is how one club behaves on the market.
+type Strategy struct {
+	Name string
+
+	Targets map[string]int // players wanted per position; more is surplus, fewer is a weak spot
+
+	ListChance  float64 // chance per round to list surplus players
+	MaxListings int     // most players listed at once
+	ListMarkup  float64 // asking price as a share of value
+	PriceJitter float64 // random noise, +/- share of the asking price
+
+	BuyChance   float64 // chance per round to buy for a weak spot
+	MaxOverpay  float64 // highest price paid as a share of value
+	BudgetShare float64 // highest price paid as a share of budget
+}
+
+// Presets are the strategies AI_CLUB_STRATEGIES can pick from.
+var Presets = map[string]Strategy{
+	"balanced": {
+		Name:        "balanced",
+		Targets:     map[string]int{"GK": 2, "DF": 6, "MF": 6, "AT": 4},
+		ListChance:  0.5,
+		MaxListings: 3,
+		ListMarkup:  1.2,
+		PriceJitter: 0.1,
+		BuyChance:   0.3,
+		MaxOverpay:  1.3,
+		BudgetShare: 0.4,
+	},
+	"seller": {
+		Name:        "seller",
+		Targets:     map[string]int{"GK": 2, "DF": 5, "MF": 5, "AT": 4},
+		ListChance:  0.8,
+		MaxListings: 5,
+		ListMarkup:  1.05,
+		PriceJitter: 0.1,
+		BuyChance:   0.15,
+		MaxOverpay:  1.1,
+		BudgetShare: 0.25,
+	},
+	"bargain_hunter": {
+		Name:        "bargain_hunter",
+		Targets:     map[string]int{"GK": 2, "DF": 7, "MF": 7, "AT": 5},
+		ListChance:  0.3,
+		MaxListings: 2,
+		ListMarkup:  1.5,
+		PriceJitter: 0.05,
+		BuyChance:   0.6,
+		MaxOverpay:  1.0,
+		BudgetShare: 0.5,
+	},
+}
+
+// ParseStrategies reads a list of preset names like "balanced,seller".
+func ParseStrategies(s string) ([]Strategy, error) {
+	var strategies []Strategy
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		strategy, ok := Presets[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown AI club strategy %q", name)
+		}
+		strategies = append(strategies, strategy)
+	}
+	if len(strategies) == 0 {
+		return nil, fmt.Errorf("no AI club strategies configured")
+	}
+	return strategies, nil
+}
+
+// Listing is a player a club puts on the market and what it asks for.
+type Listing struct {
+	Player *models.Player
+	Price  float64
+}
+
+// Sell picks the surplus players to list this round, oldest first. The
+// squad never drops below minSquad once every listed player is sold.
+func (s Strategy) Sell(rnd *rand.Rand, squad []*models.Player, minSquad int) []Listing {
+	if rnd.Float64() >= s.ListChance {
+		return nil
+	}
+
+	listed := 0
+	for _, p := range squad {
+		if p.OnTransferList {
+			listed++
+		}
+	}
+	limit := min(s.MaxListings-listed, len(squad)-listed-minSquad)
+	if limit <= 0 {
+		return nil
+	}
+
+	var surplus []*models.Player
+	for position, players := range unlistedByPosition(squad) {
+		target, ok := s.Targets[position]
+		if !ok || len(players) <= target {
+			continue
+		}
+		surplus = append(surplus, oldestFirst(players)[:len(players)-target]...)
+	}
+	surplus = oldestFirst(surplus)
+	if len(surplus) > limit {
+		surplus = surplus[:limit]
+	}
+
+	listings := make([]Listing, 0, len(surplus))
+	for _, p := range surplus {
+		noise := (rnd.Float64()*2 - 1) * s.PriceJitter
+		listings = append(listings, Listing{Player: p, Price: math.Round(p.Value * s.ListMarkup * (1 + noise))})
+	}
+	return listings
+}
+
+// Need returns the position the club most wants to strengthen this round,
+// or false when it does not buy. A full squad never buys.
+func (s Strategy) Need(rnd *rand.Rand, squad []*models.Player, maxSquad int) (string, bool) {
+	if rnd.Float64() >= s.BuyChance || len(squad) >= maxSquad {
+		return "", false
+	}
+
+	byPosition := unlistedByPosition(squad)
+	positions := make([]string, 0, len(s.Targets))
+	for position := range s.Targets {
+		positions = append(positions, position)
+	}
+	sort.Strings(positions)
+
+	need, gap := "", 0
+	for _, position := range positions {
+		if g := s.Targets[position] - len(byPosition[position]); g > gap {
+			need, gap = position, g
+		}
+	}
+	return need, gap > 0
+}
+
+// Pick chooses the listed player that is the best value for money among
+// those the club is willing and able to pay for, or nil.
+func (s Strategy) Pick(market []*models.Player, budget float64) *models.Player {
+	var best *models.Player
+	for _, p := range market {
+		if p.MarketPrice <= 0 || p.MarketPrice > p.Value*s.MaxOverpay || p.MarketPrice > budget*s.BudgetShare {
+			continue
+		}
+		if best == nil || p.Value/p.MarketPrice > best.Value/best.MarketPrice {
+			best = p
+		}
+	}
+	return best
+}
+
+func unlistedByPosition(squad []*models.Player) map[string][]*models.Player {
+	byPosition := make(map[string][]*models.Player)
+	for _, p := range squad {
+		if !p.OnTransferList {
+			byPosition[p.Position] = append(byPosition[p.Position], p)
+		}
+	}
+	return byPosition
+}
+
+// oldestFirst sorts a copy of players by age, then by value and id so the
+// order never depends on map iteration.
+func oldestFirst(players []*models.Player) []*models.Player {
+	sorted := append([]*models.Player(nil), players...)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Age != b.Age {
+			return a.Age > b.Age
+		}
+		if a.Value != b.Value {
+			return a.Value < b.Value
+		}
+		return a.ID < b.ID
+	})
+	return sorted
+}
@@ -0,0 +1,111 @@
+package aiclub
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+func newTestStrategy() Strategy {
+	return Strategy{
+		Name:        "test",
+		Targets:     map[string]int{"GK": 1, "AT": 2},
+		ListChance:  1,
+		MaxListings: 2,
+		ListMarkup:  1.5,
+		BuyChance:   1,
+		MaxOverpay:  1.2,
+		BudgetShare: 0.5,
+	}
+}
+
+func testSquad() []*models.Player {
+	return []*models.Player{
+		{ID: 1, Position: "GK", Age: 30, Value: 1000000},
+		{ID: 2, Position: "GK", Age: 35, Value: 1000000},
+		{ID: 3, Position: "GK", Age: 22, Value: 1000000},
+		{ID: 4, Position: "AT", Age: 25, Value: 1000000},
+	}
+}
+
+func TestStrategy_Sell(t *testing.T) {
+	s := newTestStrategy()
+
+	listings := s.Sell(rand.New(rand.NewSource(1)), testSquad(), 0)
+	require.Len(t, listings, 2)
+	assert.Equal(t, 2, listings[0].Player.ID)
+	assert.Equal(t, 1, listings[1].Player.ID)
+	assert.Equal(t, 1500000.0, listings[0].Price)
+
+	// the squad minimum caps what goes on the market
+	listings = s.Sell(rand.New(rand.NewSource(1)), testSquad(), 3)
+	require.Len(t, listings, 1)
+	assert.Equal(t, 2, listings[0].Player.ID)
+
+	// players already listed count against MaxListings
+	squad := testSquad()
+	squad[1].OnTransferList = true
+	listings = s.Sell(rand.New(rand.NewSource(1)), squad, 0)
+	require.Len(t, listings, 1)
+	assert.Equal(t, 1, listings[0].Player.ID)
+
+	s.ListChance = 0
+	assert.Empty(t, s.Sell(rand.New(rand.NewSource(1)), testSquad(), 0))
+}
+
+func TestStrategy_Sell_JitterIsReproducible(t *testing.T) {
+	s := newTestStrategy()
+	s.PriceJitter = 0.1
+
+	a := s.Sell(rand.New(rand.NewSource(42)), testSquad(), 0)
+	b := s.Sell(rand.New(rand.NewSource(42)), testSquad(), 0)
+	require.Len(t, a, 2)
+	assert.Equal(t, a, b)
+	assert.InDelta(t, 1500000, a[0].Price, 150000)
+}
+
+func TestStrategy_Need(t *testing.T) {
+	s := newTestStrategy()
+	rnd := rand.New(rand.NewSource(1))
+
+	position, ok := s.Need(rnd, testSquad(), 25)
+	assert.True(t, ok)
+	assert.Equal(t, "AT", position)
+
+	_, ok = s.Need(rnd, testSquad(), 4)
+	assert.False(t, ok, "a full squad does not buy")
+
+	s.Targets = map[string]int{"GK": 1, "AT": 1}
+	_, ok = s.Need(rnd, testSquad(), 25)
+	assert.False(t, ok)
+}
+
+func TestStrategy_Pick(t *testing.T) {
+	s := newTestStrategy()
+	market := []*models.Player{
+		{ID: 10, Value: 1000000, MarketPrice: 1300000}, // above MaxOverpay
+		{ID: 11, Value: 1000000, MarketPrice: 1100000},
+		{ID: 12, Value: 1000000, MarketPrice: 900000},
+		{ID: 13, Value: 4000000, MarketPrice: 3000000}, // above BudgetShare
+	}
+
+	assert.Equal(t, 12, s.Pick(market, 5000000).ID)
+	assert.Nil(t, s.Pick(market, 1000000))
+}
+
+func TestParseStrategies(t *testing.T) {
+	strategies, err := ParseStrategies("balanced, seller")
+	require.NoError(t, err)
+	require.Len(t, strategies, 2)
+	assert.Equal(t, "seller", strategies[1].Name)
+
+	_, err = ParseStrategies("balanced,reckless")
+	assert.Error(t, err)
+
+	_, err = ParseStrategies("")
+	assert.Error(t, err)
+}
@@ -1,11 +1,15 @@
 package api
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 type AppError struct {
-	Err    error
-	Msg    string
-	Status int
+	Err        error
+	Msg        string
+	Status     int
+	RetryAfter time.Duration
 }
 
 func (e *AppError) Error() string {
@@ -32,6 +36,20 @@ func ErrUnauthorized(msg string) *AppError {
 	return NewError(nil, http.StatusUnauthorized, msg)
 }
 
+func ErrForbidden(msg string) *AppError {
+	return NewError(nil, http.StatusForbidden, msg)
+}
+
 func ErrNotFound(msg string) *AppError {
 	return NewError(nil, http.StatusNotFound, msg)
 }
+
+func ErrConflict(msg string) *AppError {
+	return NewError(nil, http.StatusConflict, msg)
+}
+
+func ErrTooManyRequests(msg string, retryAfter time.Duration) *AppError {
+	e := NewError(nil, http.StatusTooManyRequests, msg)
+	e.RetryAfter = retryAfter
+	return e
+}
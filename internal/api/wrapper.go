@@ -3,7 +3,9 @@ package api
 import (
 	"encoding/json"
 	"log"
+	"math"
 	"net/http"
+	"strconv"
 )
 
 type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
@@ -17,6 +19,10 @@ func Make(h HandlerFunc) http.HandlerFunc {
 					log.Printf("Internal Error: %v", e.Err)
 				}
 
+				if e.RetryAfter > 0 {
+					w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))))
+				}
+
 				WriteError(w, e.Status, e.Msg)
 				return
 			}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+type AccountExport struct {
+	ExportedAt       time.Time         `json:"exported_at"`
+	Profile          *User             `json:"profile"`
+	Sessions         []*SessionInfo    `json:"sessions"`
+	Team             *Team             `json:"team"`
+	Squad            []*Player         `json:"squad"`
+	SquadValue       float64           `json:"squad_value"`
+	TransferListings []*Player         `json:"transfer_listings"`
+	Transfers        []*TransferRecord `json:"transfers"`
+}
+
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
+func (r *DeleteAccountRequest) Validate() error {
+	if r.Password == "" {
+		return errors.New("password_required")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	AuctionOpen      = "open"
+	AuctionSold      = "sold"
+	AuctionUnsold    = "unsold"
+	AuctionCancelled = "cancelled"
+)
+
+// Auction is a timed listing. While a bid leads, its amount is held out of
+// the bidder's budget; it is refunded when outbid and paid to the seller on
+// settlement.
+type Auction struct {
+	ID               int       `json:"id"`
+	PlayerID         int       `json:"player_id"`
+	SellerTeamID     int       `json:"seller_team_id"`
+	ReservePrice     float64   `json:"reserve_price"`
+	MinIncrement     float64   `json:"min_increment"`
+	HighBid          *float64  `json:"high_bid,omitempty"`
+	HighBidderTeamID *int      `json:"high_bidder_team_id,omitempty"`
+	Status           string    `json:"status"`
+	EndsAt           time.Time `json:"ends_at"`
+	CreatedAt        time.Time `json:"created_at"`
+	Player           *Player   `json:"player,omitempty"`
+	Bids             []*Bid    `json:"bids,omitempty"`
+}
+
+// MinimumBid is the lowest amount the next bid may offer.
+func (a *Auction) MinimumBid() float64 {
+	if a.HighBid == nil || a.HighBidderTeamID == nil {
+		return a.ReservePrice
+	}
+	return *a.HighBid + a.MinIncrement
+}
+
+type Bid struct {
+	ID        int       `json:"id"`
+	AuctionID int       `json:"auction_id"`
+	TeamID    int       `json:"team_id"`
+	Amount    float64   `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreateAuctionRequest struct {
+	PlayerID     int       `json:"player_id"`
+	ReservePrice float64   `json:"reserve_price"`
+	MinIncrement float64   `json:"min_increment"`
+	EndsAt       time.Time `json:"ends_at"`
+}
+
+func (r *CreateAuctionRequest) Validate() error {
+	if r.PlayerID <= 0 {
+		return errors.New("invalid_id")
+	}
+	if r.ReservePrice <= 0 {
+		return errors.New("invalid_reserve_price")
+	}
+	if r.MinIncrement < 0 {
+		return errors.New("invalid_min_increment")
+	}
+	if r.EndsAt.IsZero() {
+		return errors.New("invalid_auction_end")
+	}
+	return nil
+}
+
+type PlaceBidRequest struct {
+	Amount float64 `json:"amount"`
+}
+
+func (r *PlaceBidRequest) Validate() error {
+	if r.Amount <= 0 {
+		return errors.New("invalid_bid_amount")
+	}
+	return nil
+}
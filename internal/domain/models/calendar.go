@@ -0,0 +1,47 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// TransferWindow is a period in which players may change hands. Windows
+// seeded from the recurring calendar carry a SeasonKey such as "summer-2026";
+// windows created by admins do not.
+type TransferWindow struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	SeasonKey *string   `json:"season_key,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (w *TransferWindow) IsOpen(now time.Time) bool {
+	return !now.Before(w.StartsAt) && now.Before(w.EndsAt)
+}
+
+type Calendar struct {
+	Now        time.Time         `json:"now"`
+	WindowOpen bool              `json:"transfer_window_open"`
+	Enforced   bool              `json:"windows_enforced"`
+	Current    *TransferWindow   `json:"current_window"`
+	Upcoming   []*TransferWindow `json:"upcoming_windows"`
+}
+
+type CreateWindowRequest struct {
+	Name     string    `json:"name"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+func (r *CreateWindowRequest) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return errors.New("window_name_required")
+	}
+	if r.StartsAt.IsZero() || !r.EndsAt.After(r.StartsAt) {
+		return errors.New("invalid_window_dates")
+	}
+	return nil
+}
@@ -2,8 +2,17 @@ package models
 
 import "github.com/golang-jwt/jwt/v5"
 
+const (
+	TokenTypeAccess      = "access"
+	TokenTypeRefresh     = "refresh"
+	TokenTypeMFAPending  = "mfa_pending"
+	TokenTypeVerifyEmail = "verify_email"
+)
+
 type AuthClaims struct {
 	UserID    int    `json:"user_id"`
 	TokenType string `json:"token_type"`
+	SessionID int    `json:"sid,omitempty"`
+	Role      string `json:"role,omitempty"`
 	jwt.RegisteredClaims
 }
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// IdempotencyRecord is a stored request/response pair for an Idempotency-Key.
+// StatusCode is nil while the first request is still being processed.
+type IdempotencyRecord struct {
+	UserID       int
+	Key          string
+	RequestHash  string
+	StatusCode   *int
+	ContentType  string
+	ResponseBody []byte
+	ExpiresAt    time.Time
+}
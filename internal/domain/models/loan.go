@@ -0,0 +1,51 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	LoanPending   = "pending"
+	LoanActive    = "active"
+	LoanReturned  = "returned"
+	LoanRejected  = "rejected"
+	LoanCancelled = "cancelled"
+	LoanExpired   = "expired"
+)
+
+// Loan lends a player to another team for a number of game days. The
+// borrowing team asks, pays the fee when the lender accepts, and has the
+// player in its squad until the loan ends.
+type Loan struct {
+	ID             int        `json:"id"`
+	PlayerID       int        `json:"player_id"`
+	LenderTeamID   int        `json:"lender_team_id"`
+	BorrowerTeamID int        `json:"borrower_team_id"`
+	Fee            float64    `json:"fee"`
+	Days           int        `json:"days"`
+	Status         string     `json:"status"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+	EndsAt         *time.Time `json:"ends_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	RespondedAt    *time.Time `json:"responded_at,omitempty"`
+	ReturnedAt     *time.Time `json:"returned_at,omitempty"`
+	Player         *Player    `json:"player,omitempty"`
+}
+
+type LoanRequest struct {
+	PlayerID int     `json:"player_id"`
+	Days     int     `json:"days"`
+	Fee      float64 `json:"fee"`
+}
+
+func (r *LoanRequest) Validate() error {
+	if r.PlayerID <= 0 {
+		return errors.New("invalid_id")
+	}
+	if r.Fee < 0 {
+		return errors.New("invalid_loan_fee")
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	MarketSortPrice    = "price"
+	MarketSortValue    = "value"
+	MarketSortAge      = "age"
+	MarketSortListedAt = "listed_at"
+
+	DefaultMarketLimit = 20
+	MaxMarketLimit     = 100
+)
+
+// MarketFilter narrows and orders GET /transfer/market. Nil bounds are open.
+type MarketFilter struct {
+	Position     string
+	Country      string
+	MinAge       *int
+	MaxAge       *int
+	MinPrice     *float64
+	MaxPrice     *float64
+	MinValue     *float64
+	MaxValue     *float64
+	SellerTeamID *int
+	NewToday     bool
+	ListedSince  *time.Time
+	Sort         string
+	Desc         bool
+	Limit        int
+	Cursor       *MarketCursor
+}
+
+func (f *MarketFilter) Validate() error {
+	switch f.Sort {
+	case MarketSortPrice, MarketSortValue, MarketSortAge, MarketSortListedAt:
+	default:
+		return errors.New("invalid_market_sort")
+	}
+	if f.Limit < 1 || f.Limit > MaxMarketLimit {
+		return errors.New("invalid_market_limit")
+	}
+	if f.MinAge != nil && f.MaxAge != nil && *f.MinAge > *f.MaxAge {
+		return errors.New("invalid_market_range")
+	}
+	if f.MinPrice != nil && f.MaxPrice != nil && *f.MinPrice > *f.MaxPrice {
+		return errors.New("invalid_market_range")
+	}
+	if f.MinValue != nil && f.MaxValue != nil && *f.MinValue > *f.MaxValue {
+		return errors.New("invalid_market_range")
+	}
+	if f.Cursor != nil && (f.Cursor.Sort != f.Sort || f.Cursor.Desc != f.Desc) {
+		return errors.New("invalid_cursor")
+	}
+	return nil
+}
+
+// MarketCursor points just past the last row of a page: the sort key of that
+// row plus its id as a tie-breaker. It is handed to clients as an opaque string.
+type MarketCursor struct {
+	Sort string `json:"s"`
+	Desc bool   `json:"d,omitempty"`
+	Key  string `json:"k"`
+	ID   int    `json:"id"`
+}
+
+// NewMarketCursor builds the cursor that continues after p.
+func NewMarketCursor(sort string, desc bool, p *Player) *MarketCursor {
+	c := &MarketCursor{Sort: sort, Desc: desc, ID: p.ID}
+	switch sort {
+	case MarketSortPrice:
+		c.Key = strconv.FormatFloat(p.MarketPrice, 'f', -1, 64)
+	case MarketSortValue:
+		c.Key = strconv.FormatFloat(p.Value, 'f', -1, 64)
+	case MarketSortAge:
+		c.Key = strconv.Itoa(p.Age)
+	case MarketSortListedAt:
+		if p.ListedAt != nil {
+			c.Key = p.ListedAt.Format(time.RFC3339Nano)
+		}
+	}
+	return c
+}
+
+// KeyValue returns the sort key typed for use as a query argument.
+func (c *MarketCursor) KeyValue() (any, error) {
+	switch c.Sort {
+	case MarketSortPrice, MarketSortValue:
+		f, err := strconv.ParseFloat(c.Key, 64)
+		if err != nil {
+			return nil, errors.New("invalid_cursor")
+		}
+		return f, nil
+	case MarketSortAge:
+		i, err := strconv.Atoi(c.Key)
+		if err != nil {
+			return nil, errors.New("invalid_cursor")
+		}
+		return i, nil
+	case MarketSortListedAt:
+		t, err := time.Parse(time.RFC3339Nano, c.Key)
+		if err != nil {
+			return nil, errors.New("invalid_cursor")
+		}
+		return t, nil
+	}
+	return nil, errors.New("invalid_cursor")
+}
+
+func (c *MarketCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeMarketCursor(s string) (*MarketCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid_cursor")
+	}
+
+	var c MarketCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Key == "" || c.ID <= 0 {
+		return nil, errors.New("invalid_cursor")
+	}
+	return &c, nil
+}
+
+type MarketPage struct {
+	Players    []*Player `json:"players"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
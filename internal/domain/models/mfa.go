@@ -0,0 +1,54 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+type LoginResult struct {
+	Session  *Session
+	MFAToken string
+}
+
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+type TOTPCodeRequest struct {
+	Code string `json:"code"`
+}
+
+func (r *TOTPCodeRequest) Validate() error {
+	if strings.TrimSpace(r.Code) == "" {
+		return errors.New("mfa_code_required")
+	}
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+func (r *DisableTOTPRequest) Validate() error {
+	if r.Password == "" {
+		return errors.New("password_required")
+	}
+	if strings.TrimSpace(r.Code) == "" {
+		return errors.New("mfa_code_required")
+	}
+	return nil
+}
+
+type MFALoginRequest struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (r *MFALoginRequest) Validate() error {
+	if strings.TrimSpace(r.Code) == "" && strings.TrimSpace(r.RecoveryCode) == "" {
+		return errors.New("mfa_code_required")
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	OfferPending   = "pending"
+	OfferAccepted  = "accepted"
+	OfferRejected  = "rejected"
+	OfferCountered = "countered"
+	OfferExpired   = "expired"
+	OfferCancelled = "cancelled"
+)
+
+// TransferOffer is a bid for a player between two teams. A counter-offer is a
+// new offer proposed by the other side that points at the one it replaces.
+type TransferOffer struct {
+	ID               int        `json:"id"`
+	PlayerID         int        `json:"player_id"`
+	BuyerTeamID      int        `json:"buyer_team_id"`
+	SellerTeamID     int        `json:"seller_team_id"`
+	ProposedByTeamID int        `json:"proposed_by_team_id"`
+	ParentOfferID    *int       `json:"parent_offer_id,omitempty"`
+	Amount           float64    `json:"amount"`
+	Status           string     `json:"status"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+	RespondedAt      *time.Time `json:"responded_at,omitempty"`
+	Player           *Player    `json:"player,omitempty"`
+}
+
+// RecipientTeamID is the team expected to answer the offer.
+func (o *TransferOffer) RecipientTeamID() int {
+	if o.ProposedByTeamID == o.BuyerTeamID {
+		return o.SellerTeamID
+	}
+	return o.BuyerTeamID
+}
+
+type CreateOfferRequest struct {
+	PlayerID int     `json:"player_id"`
+	Amount   float64 `json:"amount"`
+}
+
+func (r *CreateOfferRequest) Validate() error {
+	if r.PlayerID <= 0 {
+		return errors.New("invalid_id")
+	}
+	if r.Amount <= 0 {
+		return errors.New("invalid_offer_amount")
+	}
+	return nil
+}
+
+type CounterOfferRequest struct {
+	Amount float64 `json:"amount"`
+}
+
+func (r *CounterOfferRequest) Validate() error {
+	if r.Amount <= 0 {
+		return errors.New("invalid_offer_amount")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+)
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+func (r *ChangePasswordRequest) Validate() error {
+	if r.CurrentPassword == "" {
+		return errors.New("password_required")
+	}
+
+	if len(r.NewPassword) < 6 {
+		return errors.New("password_short")
+	}
+
+	return nil
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+func (r *ForgotPasswordRequest) Validate() error {
+	if strings.TrimSpace(r.Email) == "" {
+		return errors.New("email_required")
+	}
+
+	if _, err := mail.ParseAddress(r.Email); err != nil {
+		return errors.New("invalid_email")
+	}
+
+	return nil
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+func (r *ResetPasswordRequest) Validate() error {
+	if strings.TrimSpace(r.Token) == "" {
+		return errors.New("reset_token_required")
+	}
+
+	if len(r.NewPassword) < 6 {
+		return errors.New("password_short")
+	}
+
+	return nil
+}
@@ -1,14 +1,42 @@
 package models
 
+import (
+	"errors"
+	"time"
+)
+
 type Player struct {
-	ID             int     `json:"id"`
-	TeamID         int     `json:"team_id"`
-	FirstName      string  `json:"first_name"`
-	LastName       string  `json:"last_name"`
-	Country        string  `json:"country"`
-	Age            int     `json:"age"`
-	Position       string  `json:"position"`
-	Value          float64 `json:"value"`
-	MarketPrice    float64 `json:"market_price,omitempty"`
-	OnTransferList bool    `json:"on_transfer_list"`
+	ID               int        `json:"id"`
+	TeamID           int        `json:"team_id"`
+	FirstName        string     `json:"first_name"`
+	LastName         string     `json:"last_name"`
+	Country          string     `json:"country"`
+	Age              int        `json:"age"`
+	Position         string     `json:"position"`
+	Value            float64    `json:"value"`
+	BaseValue        float64    `json:"-"`
+	MarketPrice      float64    `json:"market_price,omitempty"`
+	OnTransferList   bool       `json:"on_transfer_list"`
+	ReleaseClause    *float64   `json:"release_clause,omitempty"`
+	ListedAt         *time.Time `json:"listed_at,omitempty"`
+	ListingExpiresAt *time.Time `json:"listing_expires_at,omitempty"`
+	DelistedAt       *time.Time `json:"-"`
+	NewToday         bool       `json:"new_today,omitempty"`
+	SigningFee       float64    `json:"signing_fee,omitempty"`
+}
+
+const (
+	DefaultFreeAgentsLimit = 20
+	MaxFreeAgentsLimit     = 100
+)
+
+type ReleaseClauseRequest struct {
+	ReleaseClause *float64 `json:"release_clause"`
+}
+
+func (r *ReleaseClauseRequest) Validate() error {
+	if r.ReleaseClause != nil && *r.ReleaseClause <= 0 {
+		return errors.New("invalid_release_clause")
+	}
+	return nil
 }
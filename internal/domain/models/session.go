@@ -9,4 +9,20 @@ type Session struct {
 	RefreshToken     string    `json:"refresh_token"`
 	AccessExpiresAt  time.Time `json:"access_expires_at"`
 	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+	UserAgent        string    `json:"user_agent"`
+	IPAddress        string    `json:"ip_address"`
+}
+
+type SessionInfo struct {
+	ID              int        `json:"id"`
+	UserAgent       string     `json:"user_agent"`
+	IPAddress       string     `json:"ip_address"`
+	CreatedAt       time.Time  `json:"created_at"`
+	LastRefreshedAt *time.Time `json:"last_refreshed_at"`
+	Current         bool       `json:"current"`
+}
+
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
 }
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	SwapPending   = "pending"
+	SwapAccepted  = "accepted"
+	SwapRejected  = "rejected"
+	SwapCancelled = "cancelled"
+	SwapExpired   = "expired"
+
+	MaxSwapPlayersPerSide = 5
+)
+
+// Swap trades players both ways between two teams. A positive Cash is paid
+// by the proposer to the recipient on top of the players, a negative one the
+// other way round.
+type Swap struct {
+	ID              int           `json:"id"`
+	ProposerTeamID  int           `json:"proposer_team_id"`
+	RecipientTeamID int           `json:"recipient_team_id"`
+	Cash            float64       `json:"cash"`
+	Status          string        `json:"status"`
+	ExpiresAt       time.Time     `json:"expires_at"`
+	CreatedAt       time.Time     `json:"created_at"`
+	RespondedAt     *time.Time    `json:"responded_at,omitempty"`
+	Players         []*SwapPlayer `json:"players"`
+}
+
+// SwapPlayer is one player in the deal and the team giving him up.
+type SwapPlayer struct {
+	PlayerID   int     `json:"player_id"`
+	FromTeamID int     `json:"from_team_id"`
+	Player     *Player `json:"player,omitempty"`
+}
+
+// PlayersFrom returns the ids of the players teamID gives up.
+func (s *Swap) PlayersFrom(teamID int) []int {
+	ids := make([]int, 0, len(s.Players))
+	for _, p := range s.Players {
+		if p.FromTeamID == teamID {
+			ids = append(ids, p.PlayerID)
+		}
+	}
+	return ids
+}
+
+type CreateSwapRequest struct {
+	OfferedPlayerIDs   []int   `json:"offered_player_ids"`
+	RequestedPlayerIDs []int   `json:"requested_player_ids"`
+	Cash               float64 `json:"cash"`
+}
+
+func (r *CreateSwapRequest) Validate() error {
+	if len(r.OfferedPlayerIDs) == 0 || len(r.RequestedPlayerIDs) == 0 ||
+		len(r.OfferedPlayerIDs) > MaxSwapPlayersPerSide || len(r.RequestedPlayerIDs) > MaxSwapPlayersPerSide {
+		return errors.New("invalid_swap_players")
+	}
+
+	seen := make(map[int]bool)
+	for _, id := range append(append([]int{}, r.OfferedPlayerIDs...), r.RequestedPlayerIDs...) {
+		if id <= 0 || seen[id] {
+			return errors.New("invalid_swap_players")
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -1,10 +1,13 @@
 package models
 
+// Team is a manager's club, or a computer-controlled one when AIStrategy is
+// set. AI clubs have no user.
 type Team struct {
-	ID      int     `json:"id"`
-	UserID  int     `json:"user_id"`
-	Name    string  `json:"name"`
-	Country string  `json:"country"`
-	Budget  float64 `json:"budget"`
-	Value   float64 `json:"total_value"`
+	ID         int     `json:"id"`
+	UserID     int     `json:"user_id"`
+	Name       string  `json:"name"`
+	Country    string  `json:"country"`
+	Budget     float64 `json:"budget"`
+	Value      float64 `json:"total_value"`
+	AIStrategy string  `json:"ai_strategy,omitempty"`
 }
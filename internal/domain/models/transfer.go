@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+const (
+	TransferKindPurchase = "purchase"
+	TransferKindAuction  = "auction"
+	TransferKindOffer    = "offer"
+	TransferKindLoan     = "loan"
+	TransferKindSwap     = "swap"
+	TransferKindRelease  = "release"
+	TransferKindSigning  = "signing"
+
+	TransferKindReleaseClause = "release_clause"
+
+	DefaultLatestTransfersLimit = 20
+	MaxLatestTransfersLimit     = 100
+)
+
+// TransferRecord is one row of the transfer ledger. Team ids are nil when the
+// player came from or went to the free-agent pool, or the team was deleted.
+type TransferRecord struct {
+	ID             int       `json:"id"`
+	PlayerID       int       `json:"player_id"`
+	PlayerName     string    `json:"player_name,omitempty"`
+	SellerTeamID   *int      `json:"seller_team_id"`
+	SellerTeamName string    `json:"seller_team_name,omitempty"`
+	BuyerTeamID    *int      `json:"buyer_team_id"`
+	BuyerTeamName  string    `json:"buyer_team_name,omitempty"`
+	Price          float64   `json:"price"`
+	ValueBefore    float64   `json:"value_before"`
+	ValueAfter     float64   `json:"value_after"`
+	Kind           string    `json:"kind"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -1,7 +1,29 @@
 package models
 
+import "time"
+
+const (
+	RoleManager   = "manager"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
 type User struct {
-	ID       int    `json:"id"`
-	Email    string `json:"email"`
-	Password string `json:"password,omitempty"`
+	ID         int        `json:"id"`
+	Email      string     `json:"email"`
+	Password   string     `json:"password,omitempty"`
+	Role       string     `json:"role"`
+	VerifiedAt *time.Time `json:"verified_at"`
+	BannedAt   *time.Time `json:"banned_at,omitempty"`
+
+	TOTPSecret    string     `json:"-"`
+	TOTPEnabledAt *time.Time `json:"totp_enabled_at,omitempty"`
+}
+
+func IsValidRole(role string) bool {
+	switch role {
+	case RoleManager, RoleModerator, RoleAdmin:
+		return true
+	}
+	return false
 }
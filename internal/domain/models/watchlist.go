@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+const (
+	WatchStatusListed    = "listed"
+	WatchStatusNotListed = "not_listed"
+	WatchStatusOnLoan    = "on_loan"
+	WatchStatusFreeAgent = "free_agent"
+
+	NotificationListed    = "listed"
+	NotificationPriceDrop = "price_drop"
+	NotificationSold      = "sold"
+
+	NotificationsLimit = 50
+)
+
+// WatchlistEntry is a watched player as he is now, not as he was when added.
+type WatchlistEntry struct {
+	Player   *Player   `json:"player"`
+	TeamName string    `json:"team_name,omitempty"`
+	Status   string    `json:"status"`
+	Price    *float64  `json:"price,omitempty"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+type Notification struct {
+	ID         int        `json:"id"`
+	PlayerID   int        `json:"player_id"`
+	PlayerName string     `json:"player_name"`
+	Kind       string     `json:"kind"`
+	Price      *float64   `json:"price,omitempty"`
+	OldPrice   *float64   `json:"old_price,omitempty"`
+	ReadAt     *time.Time `json:"read_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
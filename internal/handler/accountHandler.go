@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/service"
+)
+
+type AccountHandler struct {
+	svc service.AccountService
+}
+
+func NewAccountHandler(svc service.AccountService) *AccountHandler {
+	return &AccountHandler{svc: svc}
+}
+
+func (h *AccountHandler) Export(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "zip" {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_export_format"))
+	}
+
+	export, err := h.svc.Export(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("soccer-manager-export-%d", userID)
+
+	if format == "zip" {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, filename))
+		return writeExportZip(w, export)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+	return json.NewEncoder(w).Encode(export)
+}
+
+func (h *AccountHandler) Delete(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	var req models.DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	if err := h.svc.Delete(ctx, userID, req.Password); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "account_deleted"),
+	})
+}
+
+// writeExportZip splits the export into one JSON file per section.
+func writeExportZip(w http.ResponseWriter, export *models.AccountExport) error {
+	zw := zip.NewWriter(w)
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"summary.json", map[string]interface{}{
+			"exported_at": export.ExportedAt,
+			"squad_value": export.SquadValue,
+		}},
+		{"profile.json", export.Profile},
+		{"sessions.json", export.Sessions},
+		{"team.json", export.Team},
+		{"squad.json", export.Squad},
+		{"transfer_listings.json", export.TransferListings},
+		{"transfers.json", export.Transfers},
+	}
+
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.data); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
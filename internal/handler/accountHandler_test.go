@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestAccountHandler_Export(t *testing.T) {
+	export := &models.AccountExport{
+		Profile:    &models.User{ID: 9, Email: "me@test.com"},
+		Team:       &models.Team{Name: "Dinamo Tbilisi"},
+		Squad:      []*models.Player{{ID: 4, Value: 1200000}},
+		SquadValue: 1200000,
+		Transfers:  []*models.TransferRecord{{PlayerID: 4, Price: 900000, Kind: models.TransferKindPurchase}},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		mockBehavior   func(m *mocks.MockAccountService)
+		expectedStatus int
+		expectedType   string
+	}{
+		{
+			name: "Success - JSON Export",
+			mockBehavior: func(m *mocks.MockAccountService) {
+				m.EXPECT().Export(gomock.Any(), 9).Return(export, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/json",
+		},
+		{
+			name:  "Success - ZIP Export",
+			query: "?format=zip",
+			mockBehavior: func(m *mocks.MockAccountService) {
+				m.EXPECT().Export(gomock.Any(), 9).Return(export, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "application/zip",
+		},
+		{
+			name:           "Failure - Unknown Format",
+			query:          "?format=xml",
+			mockBehavior:   func(m *mocks.MockAccountService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockAccountService(ctrl)
+			handler := NewAccountHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodGet, "/me/export"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			ctx := context.WithValue(req.Context(), middleware.UserIDKey, 9)
+			req = req.WithContext(ctx)
+
+			err := handler.Export(w, req)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedType, w.Header().Get("Content-Type"))
+				if tt.expectedType == "application/zip" {
+					zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+					assert.NoError(t, err)
+
+					names := make([]string, 0, len(zr.File))
+					for _, f := range zr.File {
+						names = append(names, f.Name)
+					}
+					assert.Equal(t, []string{
+						"summary.json", "profile.json", "sessions.json", "team.json",
+						"squad.json", "transfer_listings.json", "transfers.json",
+					}, names)
+
+					summary := readZipFile(t, zr, "summary.json")
+					assert.Contains(t, summary, `"squad_value": 1200000`)
+					assert.Contains(t, readZipFile(t, zr, "transfers.json"), `"price": 900000`)
+				} else {
+					assert.Contains(t, w.Body.String(), "Dinamo Tbilisi")
+				}
+			} else {
+				assert.Error(t, err)
+				if appErr, ok := err.(*api.AppError); ok {
+					assert.Equal(t, tt.expectedStatus, appErr.Status)
+				}
+			}
+		})
+	}
+}
+
+func readZipFile(t *testing.T, zr *zip.Reader, name string) string {
+	t.Helper()
+
+	f, err := zr.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return string(data)
+}
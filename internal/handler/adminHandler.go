@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/service"
+)
+
+type AdminHandler struct {
+	svc service.AdminService
+}
+
+type AdjustBudgetRequest struct {
+	Amount float64 `json:"amount"`
+}
+
+type RenameTeamRequest struct {
+	Name string `json:"name"`
+}
+
+type RenamePlayerRequest struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+type SetRoleRequest struct {
+	Role string `json:"role"`
+}
+
+func NewAdminHandler(svc service.AdminService) *AdminHandler {
+	return &AdminHandler{svc: svc}
+}
+
+func (h *AdminHandler) AdjustBudget(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	teamID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	var req AdjustBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := h.svc.AdjustBudget(ctx, teamID, req.Amount); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "budget_adjusted"),
+	})
+}
+
+func (h *AdminHandler) RemoveListing(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.RemoveListing(ctx, playerID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "player_removed_from_list"),
+	})
+}
+
+func (h *AdminHandler) RenameTeam(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	teamID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	var req RenameTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if strings.TrimSpace(req.Name) == "" {
+		return api.ErrBadRequest(locales.T(ctx, "team_name_required"))
+	}
+
+	if err := h.svc.RenameTeam(ctx, teamID, req.Name); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "team_updated"),
+	})
+}
+
+func (h *AdminHandler) RenamePlayer(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	var req RenamePlayerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if strings.TrimSpace(req.FirstName) == "" || strings.TrimSpace(req.LastName) == "" {
+		return api.ErrBadRequest(locales.T(ctx, "player_name_required"))
+	}
+
+	if err := h.svc.RenamePlayer(ctx, playerID, req.FirstName, req.LastName); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "player_updated"),
+	})
+}
+
+func (h *AdminHandler) SetRole(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	adminID := ctx.Value(middleware.UserIDKey).(int)
+
+	userID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	var req SetRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := h.svc.SetRole(ctx, adminID, userID, req.Role); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "role_updated"),
+	})
+}
+
+func (h *AdminHandler) BanUser(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	adminID := ctx.Value(middleware.UserIDKey).(int)
+
+	userID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.BanUser(ctx, adminID, userID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "user_banned"),
+	})
+}
+
+func (h *AdminHandler) UnbanUser(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.UnbanUser(ctx, userID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "user_unbanned"),
+	})
+}
+
+func (h *AdminHandler) UnlockUser(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	userID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.UnlockUser(ctx, userID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "user_unlocked"),
+	})
+}
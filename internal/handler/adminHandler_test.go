@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestAdminHandler_AdjustBudget(t *testing.T) {
+	tests := []struct {
+		name           string
+		teamID         string
+		inputBody      map[string]interface{}
+		mockBehavior   func(m *mocks.MockAdminService)
+		expectedStatus int
+	}{
+		{
+			name:   "Success - Budget Adjusted",
+			teamID: "3",
+			inputBody: map[string]interface{}{
+				"amount": 250000,
+			},
+			mockBehavior: func(m *mocks.MockAdminService) {
+				m.EXPECT().
+					AdjustBudget(gomock.Any(), 3, 250000.0).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Failure - Negative Budget",
+			teamID: "3",
+			inputBody: map[string]interface{}{
+				"amount": -9000000,
+			},
+			mockBehavior: func(m *mocks.MockAdminService) {
+				m.EXPECT().
+					AdjustBudget(gomock.Any(), 3, -9000000.0).
+					Return(api.ErrBadRequest("budget_negative"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Failure - Invalid Team ID",
+			teamID: "abc",
+			inputBody: map[string]interface{}{
+				"amount": 1,
+			},
+			mockBehavior:   func(m *mocks.MockAdminService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Failure - Database Error",
+			teamID: "3",
+			inputBody: map[string]interface{}{
+				"amount": 1,
+			},
+			mockBehavior: func(m *mocks.MockAdminService) {
+				m.EXPECT().
+					AdjustBudget(gomock.Any(), 3, 1.0).
+					Return(errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockAdminService(ctrl)
+			handler := NewAdminHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			bodyBytes, _ := json.Marshal(tt.inputBody)
+			req := httptest.NewRequest(http.MethodPut, "/admin/teams/"+tt.teamID+"/budget", bytes.NewBuffer(bodyBytes))
+			req.SetPathValue("id", tt.teamID)
+			w := httptest.NewRecorder()
+
+			api.Make(handler.AdjustBudget)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/service"
+)
+
+type AuctionHandler struct {
+	svc service.AuctionService
+}
+
+func NewAuctionHandler(svc service.AuctionService) *AuctionHandler {
+	return &AuctionHandler{svc: svc}
+}
+
+func (h *AuctionHandler) CreateAuction(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	var req models.CreateAuctionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	auction, err := h.svc.CreateAuction(ctx, userID, &req)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(auction)
+}
+
+func (h *AuctionHandler) GetAuctions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	auctions, err := h.svc.GetOpenAuctions(ctx)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(auctions)
+}
+
+func (h *AuctionHandler) GetAuction(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	auction, err := h.svc.GetAuction(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(auction)
+}
+
+func (h *AuctionHandler) PlaceBid(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	var req models.PlaceBidRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	bid, err := h.svc.PlaceBid(ctx, userID, auctionID, req.Amount)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(bid)
+}
+
+func (h *AuctionHandler) CancelAuction(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.CancelAuction(ctx, userID, auctionID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "auction_cancelled"),
+	})
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestAuctionHandler_PlaceBid(t *testing.T) {
+	tests := []struct {
+		name           string
+		auctionID      string
+		inputBody      map[string]interface{}
+		mockBehavior   func(m *mocks.MockAuctionService)
+		expectedStatus int
+	}{
+		{
+			name:      "Success - Bid Placed",
+			auctionID: "4",
+			inputBody: map[string]interface{}{
+				"amount": 1200000,
+			},
+			mockBehavior: func(m *mocks.MockAuctionService) {
+				m.EXPECT().
+					PlaceBid(gomock.Any(), 7, 4, 1200000.0).
+					Return(&models.Bid{ID: 1, AuctionID: 4, TeamID: 2, Amount: 1200000}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:      "Failure - Bid Too Low",
+			auctionID: "4",
+			inputBody: map[string]interface{}{
+				"amount": 1000,
+			},
+			mockBehavior: func(m *mocks.MockAuctionService) {
+				m.EXPECT().
+					PlaceBid(gomock.Any(), 7, 4, 1000.0).
+					Return(nil, api.ErrBadRequest("bid_too_low"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "Failure - Auction Closed",
+			auctionID: "4",
+			inputBody: map[string]interface{}{
+				"amount": 1200000,
+			},
+			mockBehavior: func(m *mocks.MockAuctionService) {
+				m.EXPECT().
+					PlaceBid(gomock.Any(), 7, 4, 1200000.0).
+					Return(nil, api.ErrConflict("auction_closed"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:      "Failure - Zero Amount",
+			auctionID: "4",
+			inputBody: map[string]interface{}{
+				"amount": 0,
+			},
+			mockBehavior:   func(m *mocks.MockAuctionService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "Failure - Invalid Auction ID",
+			auctionID: "abc",
+			inputBody: map[string]interface{}{
+				"amount": 1200000,
+			},
+			mockBehavior:   func(m *mocks.MockAuctionService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockAuctionService(ctrl)
+			handler := NewAuctionHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			bodyBytes, _ := json.Marshal(tt.inputBody)
+			req := httptest.NewRequest(http.MethodPost, "/auctions/"+tt.auctionID+"/bids", bytes.NewBuffer(bodyBytes))
+			req.SetPathValue("id", tt.auctionID)
+			ctx := context.WithValue(req.Context(), middleware.UserIDKey, 7)
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			api.Make(handler.PlaceBid)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
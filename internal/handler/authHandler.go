@@ -3,11 +3,14 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
+	"strconv"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
 	"github.com/jacobpq/soccer-manager/internal/domain/models"
 	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
 	"github.com/jacobpq/soccer-manager/internal/repository"
 	"github.com/jacobpq/soccer-manager/internal/service"
 )
@@ -60,11 +63,46 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) error {
 		return api.ErrBadRequest(locales.T(ctx, err.Error()))
 	}
 
-	session, err := h.svc.Login(r.Context(), req)
+	result, err := h.svc.Login(r.Context(), req, clientInfo(r))
 	if err != nil {
+		var appErr *api.AppError
+		if errors.As(err, &appErr) && (appErr.Status == http.StatusForbidden || appErr.Status == http.StatusTooManyRequests) {
+			return appErr
+		}
 		return api.ErrUnauthorized(locales.T(r.Context(), "invalid_credentials"))
 	}
 
+	if result.MFAToken != "" {
+		return json.NewEncoder(w).Encode(map[string]interface{}{
+			"mfa_required": true,
+			"mfa_token":    result.MFAToken,
+		})
+	}
+
+	return json.NewEncoder(w).Encode(map[string]string{
+		"access_token":  result.Session.AccessToken,
+		"refresh_token": result.Session.RefreshToken,
+	})
+}
+
+func (h *AuthHandler) LoginMFA(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	var req models.MFALoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	session, err := h.svc.LoginMFA(ctx, userID, req, clientInfo(r))
+	if err != nil {
+		return err
+	}
+
 	return json.NewEncoder(w).Encode(map[string]string{
 		"access_token":  session.AccessToken,
 		"refresh_token": session.RefreshToken,
@@ -77,12 +115,248 @@ func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) error {
 		return api.ErrBadRequest("Invalid JSON")
 	}
 
-	newAccessToken, err := h.svc.RefreshToken(r.Context(), req.RefreshToken)
+	session, err := h.svc.RefreshToken(r.Context(), req.RefreshToken, clientInfo(r))
 	if err != nil {
 		return api.ErrUnauthorized(locales.T(r.Context(), "invalid_refresh_token"))
 	}
 
 	return json.NewEncoder(w).Encode(map[string]string{
-		"access_token": newAccessToken,
+		"access_token":  session.AccessToken,
+		"refresh_token": session.RefreshToken,
 	})
 }
+
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+	sessionID := ctx.Value(middleware.SessionIDKey).(int)
+
+	if err := h.svc.Logout(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "logged_out"),
+	})
+}
+
+func (h *AuthHandler) LogoutAll(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	if err := h.svc.LogoutAll(ctx, userID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "logged_out_everywhere"),
+	})
+}
+
+func (h *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+	sessionID := ctx.Value(middleware.SessionIDKey).(int)
+
+	sessions, err := h.svc.ListSessions(ctx, userID, sessionID)
+	if err != nil {
+		return api.ErrInternal(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(sessions)
+}
+
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	sessionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_session_id"))
+	}
+
+	if err := h.svc.Logout(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "session_revoked"),
+	})
+}
+
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+	sessionID := ctx.Value(middleware.SessionIDKey).(int)
+
+	var req models.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	if err := h.svc.ChangePassword(ctx, userID, sessionID, req); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "password_changed"),
+	})
+}
+
+func (h *AuthHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var req models.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	if err := h.svc.ForgotPassword(ctx, req); err != nil {
+		return api.ErrInternal(err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "password_reset_sent"),
+	})
+}
+
+func (h *AuthHandler) ResetPassword(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var req models.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	if err := h.svc.ResetPassword(ctx, req); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "password_reset_done"),
+	})
+}
+
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_verification_token"))
+	}
+
+	if err := h.svc.VerifyEmail(ctx, token); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "email_verified"),
+	})
+}
+
+func (h *AuthHandler) ResendVerification(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	if err := h.svc.ResendVerification(ctx, userID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "verification_sent"),
+	})
+}
+
+func (h *AuthHandler) EnrollTOTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	enrollment, err := h.svc.EnrollTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(enrollment)
+}
+
+func (h *AuthHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	var req models.TOTPCodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	codes, err := h.svc.ConfirmTOTP(ctx, userID, req.Code)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":         locales.T(ctx, "mfa_enabled"),
+		"recovery_codes": codes,
+	})
+}
+
+func (h *AuthHandler) DisableTOTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	var req models.DisableTOTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	if err := h.svc.DisableTOTP(ctx, userID, req); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "mfa_disabled"),
+	})
+}
+
+func clientInfo(r *http.Request) models.ClientInfo {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return models.ClientInfo{
+		UserAgent: r.UserAgent(),
+		IPAddress: ip,
+	}
+}
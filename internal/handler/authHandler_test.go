@@ -2,17 +2,21 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
 	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
 	"github.com/jacobpq/soccer-manager/internal/mocks"
 )
 
@@ -32,14 +36,28 @@ func TestAuthHandler_Login(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockAuthService) {
 				m.EXPECT().
-					Login(gomock.Any(), models.LoginRequest{Email: "test@test.com", Password: "password123"}).
-					Return(&models.Session{
+					Login(gomock.Any(), models.LoginRequest{Email: "test@test.com", Password: "password123"}, gomock.Any()).
+					Return(&models.LoginResult{Session: &models.Session{
 						AccessToken:  "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.e30.signature",
 						RefreshToken: "random-opaque-string",
-					}, nil)
+					}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "random-opaque-string",
+		},
+		{
+			name: "Success - Second Factor Required",
+			inputBody: models.LoginRequest{
+				Email:    "mfa@test.com",
+				Password: "password123",
+			},
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					Login(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&models.LoginResult{MFAToken: "mfa-pending-token"}, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   "access-123",
+			expectedBody:   "mfa-pending-token",
 		},
 		{
 			name: "Failure - Invalid Credentials",
@@ -49,12 +67,26 @@ func TestAuthHandler_Login(t *testing.T) {
 			},
 			mockBehavior: func(m *mocks.MockAuthService) {
 				m.EXPECT().
-					Login(gomock.Any(), gomock.Any()).
+					Login(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("invalid credentials"))
 			},
 			expectedStatus: http.StatusUnauthorized,
 			expectedBody:   "",
 		},
+		{
+			name: "Failure - Account Locked",
+			inputBody: models.LoginRequest{
+				Email:    "locked@test.com",
+				Password: "password123",
+			},
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					Login(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, api.ErrTooManyRequests("too_many_attempts", 90*time.Second))
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody:   "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -91,3 +123,199 @@ func TestAuthHandler_Login(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthHandler_Logout(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         int
+		sessionID      int
+		mockBehavior   func(m *mocks.MockAuthService)
+		expectedStatus int
+	}{
+		{
+			name:      "Success - Session Revoked",
+			userID:    7,
+			sessionID: 42,
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					Logout(gomock.Any(), 7, 42).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Failure - Session Not Found",
+			userID:    7,
+			sessionID: 42,
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					Logout(gomock.Any(), 7, 42).
+					Return(api.ErrNotFound(locales.T(context.Background(), "session_not_found")))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockAuthService(ctrl)
+			handler := NewAuthHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodPost, "/logout", nil)
+			w := httptest.NewRecorder()
+
+			ctx := context.WithValue(req.Context(), middleware.UserIDKey, tt.userID)
+			ctx = context.WithValue(ctx, middleware.SessionIDKey, tt.sessionID)
+			req = req.WithContext(ctx)
+
+			err := handler.Logout(w, req)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, w.Code)
+			} else {
+				assert.Error(t, err)
+				if appErr, ok := err.(*api.AppError); ok {
+					assert.Equal(t, tt.expectedStatus, appErr.Status)
+				} else {
+					t.Errorf("Expected api.AppError, got %T", err)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_Refresh(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputBody      RefreshRequest
+		mockBehavior   func(m *mocks.MockAuthService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:      "Success - Token Rotated",
+			inputBody: RefreshRequest{RefreshToken: "old-refresh"},
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					RefreshToken(gomock.Any(), "old-refresh", gomock.Any()).
+					Return(&models.Session{
+						AccessToken:  "new-access",
+						RefreshToken: "new-refresh",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "new-refresh",
+		},
+		{
+			name:      "Failure - Reused Token",
+			inputBody: RefreshRequest{RefreshToken: "rotated-refresh"},
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					RefreshToken(gomock.Any(), "rotated-refresh", gomock.Any()).
+					Return(nil, errors.New("invalid token"))
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockAuthService(ctrl)
+			handler := NewAuthHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			bodyBytes, _ := json.Marshal(tt.inputBody)
+			req := httptest.NewRequest(http.MethodPost, "/refresh", bytes.NewBuffer(bodyBytes))
+			w := httptest.NewRecorder()
+
+			err := handler.Refresh(w, req)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			} else {
+				assert.Error(t, err)
+				if appErr, ok := err.(*api.AppError); ok {
+					assert.Equal(t, tt.expectedStatus, appErr.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputBody      models.ResetPasswordRequest
+		mockBehavior   func(m *mocks.MockAuthService)
+		expectedStatus int
+	}{
+		{
+			name:      "Success - Password Reset",
+			inputBody: models.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"},
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					ResetPassword(gomock.Any(), models.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Failure - Expired Token",
+			inputBody: models.ResetPasswordRequest{Token: "used-token", NewPassword: "new-password"},
+			mockBehavior: func(m *mocks.MockAuthService) {
+				m.EXPECT().
+					ResetPassword(gomock.Any(), gomock.Any()).
+					Return(api.ErrBadRequest("invalid_reset_token"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Failure - Short Password",
+			inputBody:      models.ResetPasswordRequest{Token: "reset-token", NewPassword: "123"},
+			mockBehavior:   func(m *mocks.MockAuthService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockAuthService(ctrl)
+			handler := NewAuthHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			bodyBytes, _ := json.Marshal(tt.inputBody)
+			req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBuffer(bodyBytes))
+			w := httptest.NewRecorder()
+
+			err := handler.ResetPassword(w, req)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, w.Code)
+			} else {
+				assert.Error(t, err)
+				if appErr, ok := err.(*api.AppError); ok {
+					assert.Equal(t, tt.expectedStatus, appErr.Status)
+				} else {
+					t.Errorf("Expected api.AppError, got %T", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/service"
+)
+
+type CalendarHandler struct {
+	svc service.CalendarService
+}
+
+func NewCalendarHandler(svc service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{svc: svc}
+}
+
+func (h *CalendarHandler) GetCalendar(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	calendar, err := h.svc.GetCalendar(ctx)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(calendar)
+}
+
+func (h *CalendarHandler) CreateWindow(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	var req models.CreateWindowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	window, err := h.svc.CreateWindow(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(window)
+}
+
+func (h *CalendarHandler) OpenWindow(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	windowID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.OpenWindow(ctx, windowID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "window_opened"),
+	})
+}
+
+func (h *CalendarHandler) CloseWindow(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	windowID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.CloseWindow(ctx, windowID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "window_closed"),
+	})
+}
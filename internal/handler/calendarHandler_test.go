@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestCalendarHandler_CloseWindow(t *testing.T) {
+	tests := []struct {
+		name           string
+		windowID       string
+		mockBehavior   func(m *mocks.MockCalendarService)
+		expectedStatus int
+	}{
+		{
+			name:     "Success - Window Closed",
+			windowID: "2",
+			mockBehavior: func(m *mocks.MockCalendarService) {
+				m.EXPECT().CloseWindow(gomock.Any(), 2).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Failure - Already Ended",
+			windowID: "2",
+			mockBehavior: func(m *mocks.MockCalendarService) {
+				m.EXPECT().CloseWindow(gomock.Any(), 2).Return(api.ErrConflict("window_already_ended"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:     "Failure - Not Found",
+			windowID: "99",
+			mockBehavior: func(m *mocks.MockCalendarService) {
+				m.EXPECT().CloseWindow(gomock.Any(), 99).Return(api.ErrNotFound("window_not_found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Failure - Invalid Window ID",
+			windowID:       "abc",
+			mockBehavior:   func(m *mocks.MockCalendarService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockCalendarService(ctrl)
+			handler := NewCalendarHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/calendar/windows/"+tt.windowID+"/close", nil)
+			req.SetPathValue("id", tt.windowID)
+			w := httptest.NewRecorder()
+
+			api.Make(handler.CloseWindow)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
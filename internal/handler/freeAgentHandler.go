@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/service"
+)
+
+type FreeAgentHandler struct {
+	svc service.FreeAgentService
+}
+
+func NewFreeAgentHandler(svc service.FreeAgentService) *FreeAgentHandler {
+	return &FreeAgentHandler{svc: svc}
+}
+
+func (h *FreeAgentHandler) GetFreeAgents(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	limit := models.DefaultFreeAgentsLimit
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > models.MaxFreeAgentsLimit {
+			return api.ErrBadRequest(locales.T(ctx, "invalid_limit"))
+		}
+		limit = n
+	}
+
+	players, err := h.svc.GetFreeAgents(ctx, q.Get("position"), limit)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(players)
+}
+
+func (h *FreeAgentHandler) Sign(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.Sign(ctx, userID, playerID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "free_agent_signed"),
+	})
+}
+
+func (h *FreeAgentHandler) Release(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.Release(ctx, userID, playerID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "player_released"),
+	})
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestFreeAgentHandler_GetFreeAgents(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		mockBehavior   func(m *mocks.MockFreeAgentService)
+		expectedStatus int
+	}{
+		{
+			name:  "Success - Default Limit",
+			query: "",
+			mockBehavior: func(m *mocks.MockFreeAgentService) {
+				m.EXPECT().GetFreeAgents(gomock.Any(), "", models.DefaultFreeAgentsLimit).Return([]*models.Player{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Success - Position Filter",
+			query: "?position=GK&limit=5",
+			mockBehavior: func(m *mocks.MockFreeAgentService) {
+				m.EXPECT().GetFreeAgents(gomock.Any(), "GK", 5).Return([]*models.Player{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Failure - Invalid Limit",
+			query:          "?limit=1000",
+			mockBehavior:   func(m *mocks.MockFreeAgentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockFreeAgentService(ctrl)
+			handler := NewFreeAgentHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodGet, "/free-agents"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			api.Make(handler.GetFreeAgents)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestFreeAgentHandler_Sign(t *testing.T) {
+	tests := []struct {
+		name           string
+		playerID       string
+		mockBehavior   func(m *mocks.MockFreeAgentService)
+		expectedStatus int
+	}{
+		{
+			name:     "Success - Player Signed",
+			playerID: "9",
+			mockBehavior: func(m *mocks.MockFreeAgentService) {
+				m.EXPECT().Sign(gomock.Any(), 3, 9).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Failure - Not A Free Agent",
+			playerID: "9",
+			mockBehavior: func(m *mocks.MockFreeAgentService) {
+				m.EXPECT().Sign(gomock.Any(), 3, 9).Return(api.ErrNotFound("free_agent_not_found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:     "Failure - Squad Full",
+			playerID: "9",
+			mockBehavior: func(m *mocks.MockFreeAgentService) {
+				m.EXPECT().Sign(gomock.Any(), 3, 9).Return(api.ErrConflict("squad_size_limit"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Failure - Invalid Player ID",
+			playerID:       "x",
+			mockBehavior:   func(m *mocks.MockFreeAgentService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockFreeAgentService(ctrl)
+			handler := NewFreeAgentHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodPost, "/free-agents/"+tt.playerID+"/sign", nil)
+			req.SetPathValue("id", tt.playerID)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 3))
+			w := httptest.NewRecorder()
+
+			api.Make(handler.Sign)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/jacobpq/soccer-manager/internal/keys"
+)
+
+type JWKSHandler struct {
+	keys *keys.KeySet
+}
+
+func NewJWKSHandler(ks *keys.KeySet) *JWKSHandler {
+	return &JWKSHandler{keys: ks}
+}
+
+func (h *JWKSHandler) GetJWKS(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(keys.JWKSMaxAge.Seconds())))
+	return json.NewEncoder(w).Encode(h.keys.JWKS())
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/service"
+)
+
+type OfferHandler struct {
+	svc service.OfferService
+}
+
+func NewOfferHandler(svc service.OfferService) *OfferHandler {
+	return &OfferHandler{svc: svc}
+}
+
+func (h *OfferHandler) CreateOffer(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	var req models.CreateOfferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	offer, err := h.svc.CreateOffer(ctx, userID, req.PlayerID, req.Amount)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(offer)
+}
+
+func (h *OfferHandler) GetIncoming(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	offers, err := h.svc.GetIncoming(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(offers)
+}
+
+func (h *OfferHandler) GetOutgoing(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	offers, err := h.svc.GetOutgoing(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(offers)
+}
+
+func (h *OfferHandler) AcceptOffer(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	offerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.AcceptOffer(ctx, userID, offerID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "offer_accepted"),
+	})
+}
+
+func (h *OfferHandler) RejectOffer(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	offerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.RejectOffer(ctx, userID, offerID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "offer_rejected"),
+	})
+}
+
+func (h *OfferHandler) CounterOffer(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	offerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	var req models.CounterOfferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	counter, err := h.svc.CounterOffer(ctx, userID, offerID, req.Amount)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(counter)
+}
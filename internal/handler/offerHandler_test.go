@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestOfferHandler_AcceptOffer(t *testing.T) {
+	tests := []struct {
+		name           string
+		offerID        string
+		mockBehavior   func(m *mocks.MockOfferService)
+		expectedStatus int
+	}{
+		{
+			name:    "Success - Offer Accepted",
+			offerID: "12",
+			mockBehavior: func(m *mocks.MockOfferService) {
+				m.EXPECT().AcceptOffer(gomock.Any(), 5, 12).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Failure - Not The Recipient",
+			offerID: "12",
+			mockBehavior: func(m *mocks.MockOfferService) {
+				m.EXPECT().AcceptOffer(gomock.Any(), 5, 12).Return(api.ErrForbidden("offer_awaiting_other_side"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "Failure - Offer Expired",
+			offerID: "12",
+			mockBehavior: func(m *mocks.MockOfferService) {
+				m.EXPECT().AcceptOffer(gomock.Any(), 5, 12).Return(api.ErrConflict("offer_expired"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Failure - Invalid Offer ID",
+			offerID:        "abc",
+			mockBehavior:   func(m *mocks.MockOfferService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Failure - Database Error",
+			offerID: "12",
+			mockBehavior: func(m *mocks.MockOfferService) {
+				m.EXPECT().AcceptOffer(gomock.Any(), 5, 12).Return(errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockOfferService(ctrl)
+			handler := NewOfferHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodPost, "/offers/"+tt.offerID+"/accept", nil)
+			req.SetPathValue("id", tt.offerID)
+			ctx := context.WithValue(req.Context(), middleware.UserIDKey, 5)
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			api.Make(handler.AcceptOffer)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestSwapHandler_CreateSwap(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputBody      map[string]interface{}
+		mockBehavior   func(m *mocks.MockSwapService)
+		expectedStatus int
+	}{
+		{
+			name: "Success - Swap Proposed",
+			inputBody: map[string]interface{}{
+				"offered_player_ids":   []int{3},
+				"requested_player_ids": []int{8},
+				"cash":                 500000,
+			},
+			mockBehavior: func(m *mocks.MockSwapService) {
+				m.EXPECT().
+					CreateSwap(gomock.Any(), 5, &models.CreateSwapRequest{OfferedPlayerIDs: []int{3}, RequestedPlayerIDs: []int{8}, Cash: 500000}).
+					Return(&models.Swap{ID: 1, Cash: 500000, Status: models.SwapPending}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "Failure - Players From Two Teams",
+			inputBody: map[string]interface{}{
+				"offered_player_ids":   []int{3},
+				"requested_player_ids": []int{8, 9},
+			},
+			mockBehavior: func(m *mocks.MockSwapService) {
+				m.EXPECT().CreateSwap(gomock.Any(), 5, gomock.Any()).Return(nil, api.ErrBadRequest("swap_one_team"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Failure - Nothing Requested",
+			inputBody: map[string]interface{}{
+				"offered_player_ids": []int{3},
+			},
+			mockBehavior:   func(m *mocks.MockSwapService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Failure - Same Player Twice",
+			inputBody: map[string]interface{}{
+				"offered_player_ids":   []int{3},
+				"requested_player_ids": []int{3},
+			},
+			mockBehavior:   func(m *mocks.MockSwapService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockSwapService(ctrl)
+			handler := NewSwapHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			bodyBytes, _ := json.Marshal(tt.inputBody)
+			req := httptest.NewRequest(http.MethodPost, "/swaps", bytes.NewBuffer(bodyBytes))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 5))
+			w := httptest.NewRecorder()
+
+			api.Make(handler.CreateSwap)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
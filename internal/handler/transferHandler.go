@@ -2,9 +2,13 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
 	"github.com/jacobpq/soccer-manager/internal/locales"
 	"github.com/jacobpq/soccer-manager/internal/middleware"
 	"github.com/jacobpq/soccer-manager/internal/service"
@@ -37,7 +41,7 @@ func (h *TransferHandler) ListPlayer(w http.ResponseWriter, r *http.Request) err
 	}
 
 	if err := h.svc.ListPlayer(ctx, userID, req.PlayerID, req.Price); err != nil {
-		return api.ErrBadRequest(err.Error())
+		return err
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -57,7 +61,7 @@ func (h *TransferHandler) RemovePlayer(w http.ResponseWriter, r *http.Request) e
 	}
 
 	if err := h.svc.RemoveFromList(ctx, userID, req.PlayerID); err != nil {
-		return api.ErrBadRequest(err.Error())
+		return err
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -70,13 +74,115 @@ func (h *TransferHandler) RemovePlayer(w http.ResponseWriter, r *http.Request) e
 func (h *TransferHandler) GetMarket(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
-	players, err := h.svc.GetMarket(ctx)
+	filter, err := parseMarketFilter(r)
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	if err := filter.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	page, err := h.svc.GetMarket(ctx, filter)
 	if err != nil {
 		return api.NewError(err, http.StatusInternalServerError, locales.T(ctx, "market_fetch_fail"))
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	return json.NewEncoder(w).Encode(players)
+	return json.NewEncoder(w).Encode(page)
+}
+
+// parseMarketFilter reads the market query string. Listing date sorts newest
+// first unless order=asc; every other sort is ascending unless order=desc.
+func parseMarketFilter(r *http.Request) (*models.MarketFilter, error) {
+	q := r.URL.Query()
+
+	f := &models.MarketFilter{
+		Position: q.Get("position"),
+		Country:  q.Get("country"),
+		Sort:     q.Get("sort"),
+		Limit:    models.DefaultMarketLimit,
+	}
+	if f.Sort == "" {
+		f.Sort = models.MarketSortListedAt
+	}
+
+	switch q.Get("order") {
+	case "":
+		f.Desc = f.Sort == models.MarketSortListedAt
+	case "asc":
+	case "desc":
+		f.Desc = true
+	default:
+		return nil, errors.New("invalid_market_order")
+	}
+
+	var err error
+	if f.MinAge, err = queryInt(q, "min_age"); err != nil {
+		return nil, err
+	}
+	if f.MaxAge, err = queryInt(q, "max_age"); err != nil {
+		return nil, err
+	}
+	if f.SellerTeamID, err = queryInt(q, "team_id"); err != nil {
+		return nil, err
+	}
+	if f.MinPrice, err = queryFloat(q, "min_price"); err != nil {
+		return nil, err
+	}
+	if f.MaxPrice, err = queryFloat(q, "max_price"); err != nil {
+		return nil, err
+	}
+	if f.MinValue, err = queryFloat(q, "min_value"); err != nil {
+		return nil, err
+	}
+	if f.MaxValue, err = queryFloat(q, "max_value"); err != nil {
+		return nil, err
+	}
+
+	if limit := q.Get("limit"); limit != "" {
+		if f.Limit, err = strconv.Atoi(limit); err != nil {
+			return nil, errors.New("invalid_market_limit")
+		}
+	}
+
+	if v := q.Get("new_today"); v != "" {
+		if f.NewToday, err = strconv.ParseBool(v); err != nil {
+			return nil, errors.New("invalid_market_query")
+		}
+	}
+
+	if cursor := q.Get("cursor"); cursor != "" {
+		if f.Cursor, err = models.DecodeMarketCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+func queryInt(q url.Values, key string) (*int, error) {
+	v := q.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, errors.New("invalid_market_query")
+	}
+	return &i, nil
+}
+
+func queryFloat(q url.Values, key string) (*float64, error) {
+	v := q.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, errors.New("invalid_market_query")
+	}
+	return &f, nil
 }
 
 func (h *TransferHandler) BuyPlayer(w http.ResponseWriter, r *http.Request) error {
@@ -89,8 +195,7 @@ func (h *TransferHandler) BuyPlayer(w http.ResponseWriter, r *http.Request) erro
 	}
 
 	if err := h.svc.BuyPlayer(ctx, userID, req.PlayerID); err != nil {
-		msg := err.Error()
-		return api.ErrBadRequest(msg)
+		return err
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -99,3 +204,192 @@ func (h *TransferHandler) BuyPlayer(w http.ResponseWriter, r *http.Request) erro
 		"status": locales.T(ctx, "transfer_success"),
 	})
 }
+
+func (h *TransferHandler) GetPlayer(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	player, err := h.svc.GetPlayer(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(player)
+}
+
+func (h *TransferHandler) SetReleaseClause(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	var req models.ReleaseClauseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	if err := h.svc.SetReleaseClause(ctx, userID, playerID, req.ReleaseClause); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "release_clause_set"),
+	})
+}
+
+func (h *TransferHandler) TriggerReleaseClause(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.TriggerReleaseClause(ctx, userID, playerID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "release_clause_triggered"),
+	})
+}
+
+func (h *TransferHandler) GetPlayerHistory(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	playerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	history, err := h.svc.GetPlayerHistory(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(history)
+}
+
+func (h *TransferHandler) GetTeamTransfers(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	transfers, err := h.svc.GetTeamTransfers(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(transfers)
+}
+
+func (h *TransferHandler) GetLatestTransfers(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	limit := models.DefaultLatestTransfersLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > models.MaxLatestTransfersLimit {
+			return api.ErrBadRequest(locales.T(ctx, "invalid_limit"))
+		}
+		limit = n
+	}
+
+	transfers, err := h.svc.GetLatestTransfers(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(transfers)
+}
+
+func (h *TransferHandler) RequestLoan(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	var req models.LoanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_json"))
+	}
+
+	if err := req.Validate(); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, err.Error()))
+	}
+
+	loan, err := h.svc.RequestLoan(ctx, userID, &req)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	return json.NewEncoder(w).Encode(loan)
+}
+
+func (h *TransferHandler) GetLoans(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	loans, err := h.svc.GetLoans(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(loans)
+}
+
+func (h *TransferHandler) AcceptLoan(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	loanID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.AcceptLoan(ctx, userID, loanID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "loan_accepted"),
+	})
+}
+
+func (h *TransferHandler) RejectLoan(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	userID := ctx.Value(middleware.UserIDKey).(int)
+
+	loanID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_id"))
+	}
+
+	if err := h.svc.RejectLoan(ctx, userID, loanID); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(map[string]string{
+		"status": locales.T(ctx, "loan_rejected"),
+	})
+}
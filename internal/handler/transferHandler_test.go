@@ -13,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
 	"github.com/jacobpq/soccer-manager/internal/middleware"
 	"github.com/jacobpq/soccer-manager/internal/mocks"
 )
@@ -47,11 +48,37 @@ func TestTransferHandler_BuyPlayer(t *testing.T) {
 			mockBehavior: func(m *mocks.MockTransferService) {
 				m.EXPECT().
 					BuyPlayer(gomock.Any(), 55, 100).
-					Return(errors.New("insufficient funds"))
+					Return(api.ErrBadRequest("insufficient_funds"))
 			},
 
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:   "Failure - Player On Loan",
+			userID: 55,
+			inputBody: map[string]interface{}{
+				"player_id": 100,
+			},
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					BuyPlayer(gomock.Any(), 55, 100).
+					Return(api.ErrConflict("player_on_loan"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:   "Failure - Window Closed",
+			userID: 55,
+			inputBody: map[string]interface{}{
+				"player_id": 100,
+			},
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					BuyPlayer(gomock.Any(), 55, 100).
+					Return(api.ErrForbidden("transfer_window_closed"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
 		{
 			name:   "Failure - Invalid JSON",
 			userID: 55,
@@ -95,3 +122,272 @@ func TestTransferHandler_BuyPlayer(t *testing.T) {
 		})
 	}
 }
+
+func TestTransferHandler_GetMarket(t *testing.T) {
+	next := (&models.MarketCursor{Sort: models.MarketSortPrice, Key: "1500000", ID: 9}).Encode()
+
+	tests := []struct {
+		name           string
+		query          string
+		mockBehavior   func(m *mocks.MockTransferService)
+		expectedStatus int
+	}{
+		{
+			name:  "Success - Default Sort",
+			query: "",
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					GetMarket(gomock.Any(), &models.MarketFilter{
+						Sort:  models.MarketSortListedAt,
+						Desc:  true,
+						Limit: models.DefaultMarketLimit,
+					}).
+					Return(&models.MarketPage{Players: []*models.Player{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Success - Filters And Cursor",
+			query: "?position=AT&min_age=20&max_price=2000000&sort=price&limit=10&cursor=" + next,
+			mockBehavior: func(m *mocks.MockTransferService) {
+				minAge, maxPrice := 20, 2000000.0
+				m.EXPECT().
+					GetMarket(gomock.Any(), &models.MarketFilter{
+						Position: "AT",
+						MinAge:   &minAge,
+						MaxPrice: &maxPrice,
+						Sort:     models.MarketSortPrice,
+						Limit:    10,
+						Cursor:   &models.MarketCursor{Sort: models.MarketSortPrice, Key: "1500000", ID: 9},
+					}).
+					Return(&models.MarketPage{Players: []*models.Player{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Failure - Cursor From Another Sort",
+			query:          "?sort=age&cursor=" + next,
+			mockBehavior:   func(m *mocks.MockTransferService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Failure - Unknown Sort",
+			query:          "?sort=name",
+			mockBehavior:   func(m *mocks.MockTransferService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Failure - Inverted Range",
+			query:          "?min_age=30&max_age=20",
+			mockBehavior:   func(m *mocks.MockTransferService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Failure - Database Error",
+			query: "",
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					GetMarket(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockTransferService(ctrl)
+			handler := NewTransferHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodGet, "/transfer/market"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			api.Make(handler.GetMarket)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestTransferHandler_GetPlayerHistory(t *testing.T) {
+	sellerID, buyerID := 1, 2
+
+	tests := []struct {
+		name           string
+		playerID       string
+		mockBehavior   func(m *mocks.MockTransferService)
+		expectedStatus int
+	}{
+		{
+			name:     "Success - History Returned",
+			playerID: "14",
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					GetPlayerHistory(gomock.Any(), 14).
+					Return([]*models.TransferRecord{
+						{ID: 3, PlayerID: 14, SellerTeamID: &sellerID, BuyerTeamID: &buyerID, Price: 1500000, Kind: models.TransferKindPurchase},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Failure - Player Not Found",
+			playerID: "14",
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					GetPlayerHistory(gomock.Any(), 14).
+					Return(nil, api.ErrNotFound("player_not_found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Failure - Invalid Player ID",
+			playerID:       "abc",
+			mockBehavior:   func(m *mocks.MockTransferService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockTransferService(ctrl)
+			handler := NewTransferHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodGet, "/players/"+tt.playerID+"/history", nil)
+			req.SetPathValue("id", tt.playerID)
+			w := httptest.NewRecorder()
+
+			api.Make(handler.GetPlayerHistory)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestTransferHandler_RequestLoan(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputBody      map[string]interface{}
+		mockBehavior   func(m *mocks.MockTransferService)
+		expectedStatus int
+	}{
+		{
+			name:      "Success - Loan Requested",
+			inputBody: map[string]interface{}{"player_id": 9, "days": 30, "fee": 50000},
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					RequestLoan(gomock.Any(), 55, &models.LoanRequest{PlayerID: 9, Days: 30, Fee: 50000}).
+					Return(&models.Loan{ID: 1, PlayerID: 9, Days: 30, Fee: 50000, Status: models.LoanPending}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:      "Failure - Player On Loan",
+			inputBody: map[string]interface{}{"player_id": 9, "days": 30, "fee": 50000},
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().
+					RequestLoan(gomock.Any(), 55, gomock.Any()).
+					Return(nil, api.ErrConflict("player_on_loan"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "Failure - Negative Fee",
+			inputBody:      map[string]interface{}{"player_id": 9, "days": 30, "fee": -1},
+			mockBehavior:   func(m *mocks.MockTransferService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockTransferService(ctrl)
+			handler := NewTransferHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			bodyBytes, _ := json.Marshal(tt.inputBody)
+			req := httptest.NewRequest(http.MethodPost, "/loans", bytes.NewBuffer(bodyBytes))
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 55))
+			w := httptest.NewRecorder()
+
+			api.Make(handler.RequestLoan)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestTransferHandler_TriggerReleaseClause(t *testing.T) {
+	tests := []struct {
+		name           string
+		playerID       string
+		mockBehavior   func(m *mocks.MockTransferService)
+		expectedStatus int
+	}{
+		{
+			name:     "Success - Clause Triggered",
+			playerID: "21",
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().TriggerReleaseClause(gomock.Any(), 55, 21).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Failure - No Clause",
+			playerID: "21",
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().TriggerReleaseClause(gomock.Any(), 55, 21).Return(api.ErrNotFound("no_release_clause"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:     "Failure - Insufficient Funds",
+			playerID: "21",
+			mockBehavior: func(m *mocks.MockTransferService) {
+				m.EXPECT().TriggerReleaseClause(gomock.Any(), 55, 21).Return(api.ErrBadRequest("insufficient_funds"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Failure - Invalid Player ID",
+			playerID:       "abc",
+			mockBehavior:   func(m *mocks.MockTransferService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockTransferService(ctrl)
+			handler := NewTransferHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodPost, "/players/"+tt.playerID+"/trigger-release-clause", nil)
+			req.SetPathValue("id", tt.playerID)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 55))
+			w := httptest.NewRecorder()
+
+			api.Make(handler.TriggerReleaseClause)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/middleware"
+	"github.com/jacobpq/soccer-manager/internal/mocks"
+)
+
+func TestWatchlistHandler_Watch(t *testing.T) {
+	tests := []struct {
+		name           string
+		playerID       string
+		mockBehavior   func(m *mocks.MockWatchlistService)
+		expectedStatus int
+	}{
+		{
+			name:     "Success - Player Watched",
+			playerID: "7",
+			mockBehavior: func(m *mocks.MockWatchlistService) {
+				m.EXPECT().Watch(gomock.Any(), 3, 7).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Failure - Watchlist Full",
+			playerID: "7",
+			mockBehavior: func(m *mocks.MockWatchlistService) {
+				m.EXPECT().Watch(gomock.Any(), 3, 7).Return(api.ErrConflict("watchlist_full"))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:     "Failure - Player Not Found",
+			playerID: "7",
+			mockBehavior: func(m *mocks.MockWatchlistService) {
+				m.EXPECT().Watch(gomock.Any(), 3, 7).Return(api.ErrNotFound("player_not_found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Failure - Invalid Player ID",
+			playerID:       "x",
+			mockBehavior:   func(m *mocks.MockWatchlistService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockWatchlistService(ctrl)
+			handler := NewWatchlistHandler(mockSvc)
+
+			tt.mockBehavior(mockSvc)
+
+			req := httptest.NewRequest(http.MethodPost, "/watchlist/"+tt.playerID, nil)
+			req.SetPathValue("playerId", tt.playerID)
+			req = req.WithContext(context.WithValue(req.Context(), middleware.UserIDKey, 3))
+			w := httptest.NewRecorder()
+
+			api.Make(handler.Watch)(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
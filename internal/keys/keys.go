@@ -0,0 +1,268 @@
+package keys
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+)
+
+// JWKSMaxAge is how long clients may cache the published key set. A key
+// added by a reload only starts signing once it has been published this
+// long, so verifiers holding the cached set already know it.
+const JWKSMaxAge = 5 * time.Minute
+
+// Key is a single signing or verification key identified by its kid.
+// Private is nil for keys that are only kept to verify older tokens.
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+// KeySet holds the keys used to sign and verify JWTs. Keys are read from PEM
+// files, the file name without extension being the kid. When no key files are
+// configured it falls back to HS256 with the shared JWT secret.
+type KeySet struct {
+	mu         sync.RWMutex
+	dir        string
+	files      []string
+	signingKID string
+	secret     []byte
+	keys       map[string]*Key
+	signing    *Key
+	seen       map[string]time.Time // when each kid was first loaded
+	now        func() time.Time
+}
+
+func Load(cfg *config.Config) (*KeySet, error) {
+	ks := &KeySet{
+		dir:        cfg.JWTKeysDir,
+		signingKID: cfg.JWTSigningKeyID,
+		secret:     []byte(cfg.JWTSecret),
+		now:        time.Now,
+	}
+	for _, f := range strings.Split(cfg.JWTKeyFiles, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			ks.files = append(ks.files, f)
+		}
+	}
+
+	if err := ks.Reload(); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Asymmetric reports whether tokens are signed with key files rather than the shared secret.
+func (ks *KeySet) Asymmetric() bool {
+	return ks.dir != "" || len(ks.files) > 0
+}
+
+// Reload re-reads all key files. The previous keys stay in place if any file
+// is invalid, so a bad rotation never takes the service down. Keys present at
+// the first load count as published already.
+func (ks *KeySet) Reload() error {
+	if !ks.Asymmetric() {
+		return nil
+	}
+
+	paths := append([]string{}, ks.files...)
+	if ks.dir != "" {
+		matches, err := filepath.Glob(filepath.Join(ks.dir, "*.pem"))
+		if err != nil {
+			return err
+		}
+		paths = append(paths, matches...)
+	}
+
+	keys := make(map[string]*Key)
+	for _, path := range paths {
+		key, err := loadKey(path)
+		if err != nil {
+			return fmt.Errorf("failed to load key %s: %w", path, err)
+		}
+		keys[key.ID] = key
+	}
+
+	now := ks.now()
+	ks.mu.RLock()
+	first, previous := ks.seen == nil, ks.seen
+	ks.mu.RUnlock()
+
+	seen := make(map[string]time.Time, len(keys))
+	for id := range keys {
+		switch t, ok := previous[id]; {
+		case ok:
+			seen[id] = t
+		case first:
+			seen[id] = time.Time{}
+		default:
+			seen[id] = now
+		}
+	}
+
+	published := func(id string) bool { return now.Sub(seen[id]) >= JWKSMaxAge }
+	signing, err := pickSigningKey(keys, ks.signingKID, published)
+	if err != nil {
+		return err
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.signing = signing
+	ks.seen = seen
+	ks.mu.Unlock()
+	return nil
+}
+
+// Watch reloads the key files every interval until ctx is cancelled.
+func (ks *KeySet) Watch(ctx context.Context, interval time.Duration) {
+	if !ks.Asymmetric() || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ks.Reload(); err != nil {
+				log.Printf("Failed to reload JWT keys: %v", err)
+			}
+		}
+	}
+}
+
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	if !ks.Asymmetric() {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(ks.secret)
+	}
+
+	ks.mu.RLock()
+	key := ks.signing
+	ks.mu.RUnlock()
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
+}
+
+// Keyfunc resolves the verification key for a token, for use with jwt.Parse.
+func (ks *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	if !ks.Asymmetric() {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return ks.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+
+	ks.mu.RLock()
+	key, ok := ks.keys[kid]
+	ks.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.Public, nil
+}
+
+func loadKey(path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	key := &Key{ID: strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))}
+
+	var parsed interface{}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Method, key.Private, key.Public = jwt.SigningMethodRS256, k, &k.PublicKey
+	case ed25519.PrivateKey:
+		key.Method, key.Private, key.Public = jwt.SigningMethodEdDSA, k, k.Public()
+	case *rsa.PublicKey:
+		key.Method, key.Public = jwt.SigningMethodRS256, k
+	case ed25519.PublicKey:
+		key.Method, key.Public = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+	return key, nil
+}
+
+// pickSigningKey uses the configured kid if set, otherwise the published
+// private key with the greatest kid, so naming keys by date makes the newest
+// one sign. Unpublished keys are only used when there is nothing else.
+func pickSigningKey(keys map[string]*Key, kid string, published func(string) bool) (*Key, error) {
+	if kid != "" {
+		key, ok := keys[kid]
+		if !ok || key.Private == nil {
+			return nil, fmt.Errorf("signing key %q not found", kid)
+		}
+		return key, nil
+	}
+
+	var ids, pending []string
+	for id, key := range keys {
+		switch {
+		case key.Private == nil:
+		case published(id):
+			ids = append(ids, id)
+		default:
+			pending = append(pending, id)
+		}
+	}
+	if len(ids) == 0 {
+		ids = pending
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no private key available for signing")
+	}
+
+	sort.Strings(ids)
+	return keys[ids[len(ids)-1]], nil
+}
@@ -0,0 +1,198 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+)
+
+func writeRSAKey(t *testing.T, dir, kid string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writePEM(t, dir, kid, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key))
+}
+
+func writeEdKey(t *testing.T, dir, kid string) {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, dir, kid, "PRIVATE KEY", der)
+}
+
+func writePEM(t *testing.T, dir, kid, blockType string, der []byte) {
+	t.Helper()
+
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+}
+
+func signedKID(t *testing.T, ks *KeySet) string {
+	t.Helper()
+
+	signed, err := ks.Sign(jwt.MapClaims{"sub": "1"})
+	require.NoError(t, err)
+
+	token, err := jwt.Parse(signed, ks.Keyfunc)
+	require.NoError(t, err)
+	return token.Header["kid"].(string)
+}
+
+func TestLoad_RSAAndEdDSA(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "2024-01")
+	writeEdKey(t, dir, "2024-02")
+
+	ks, err := Load(&config.Config{JWTKeysDir: dir})
+	require.NoError(t, err)
+
+	require.Len(t, ks.keys, 2)
+	assert.Equal(t, jwt.SigningMethodRS256, ks.keys["2024-01"].Method)
+	assert.Equal(t, jwt.SigningMethodEdDSA, ks.keys["2024-02"].Method)
+
+	assert.Equal(t, "2024-02", signedKID(t, ks))
+
+	ks, err = Load(&config.Config{JWTKeysDir: dir, JWTSigningKeyID: "2024-01"})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01", signedKID(t, ks))
+}
+
+func TestReload_KeepsOldKeysOnError(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "2024-01")
+
+	ks, err := Load(&config.Config{JWTKeysDir: dir})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2024-02.pem"), []byte("not a key"), 0o600))
+	assert.Error(t, ks.Reload())
+
+	assert.Len(t, ks.keys, 1)
+	assert.Equal(t, "2024-01", signedKID(t, ks))
+}
+
+func TestReload_NewKeySignsOnceCachesExpire(t *testing.T) {
+	dir := t.TempDir()
+	writeEdKey(t, dir, "2024-01")
+
+	ks, err := Load(&config.Config{JWTKeysDir: dir})
+	require.NoError(t, err)
+
+	start := time.Now()
+	ks.now = func() time.Time { return start }
+
+	writeEdKey(t, dir, "2024-02")
+	require.NoError(t, ks.Reload())
+	assert.Equal(t, "2024-01", signedKID(t, ks), "the new key is published but does not sign yet")
+	assert.Len(t, ks.JWKS().Keys, 2)
+
+	ks.now = func() time.Time { return start.Add(JWKSMaxAge) }
+	require.NoError(t, ks.Reload())
+	assert.Equal(t, "2024-02", signedKID(t, ks))
+}
+
+func TestPickSigningKey(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keys := map[string]*Key{
+		"a": {ID: "a", Method: jwt.SigningMethodEdDSA, Private: priv, Public: priv.Public()},
+		"b": {ID: "b", Method: jwt.SigningMethodEdDSA, Private: priv, Public: priv.Public()},
+		"c": {ID: "c", Method: jwt.SigningMethodEdDSA, Public: priv.Public()},
+	}
+	all := func(string) bool { return true }
+
+	key, err := pickSigningKey(keys, "", all)
+	require.NoError(t, err)
+	assert.Equal(t, "b", key.ID, "greatest kid with a private key")
+
+	key, err = pickSigningKey(keys, "a", all)
+	require.NoError(t, err)
+	assert.Equal(t, "a", key.ID)
+
+	_, err = pickSigningKey(keys, "c", all)
+	assert.Error(t, err, "public-only keys cannot sign")
+	_, err = pickSigningKey(keys, "z", all)
+	assert.Error(t, err)
+
+	key, err = pickSigningKey(keys, "", func(id string) bool { return id != "b" })
+	require.NoError(t, err)
+	assert.Equal(t, "a", key.ID, "unpublished keys wait")
+
+	key, err = pickSigningKey(keys, "", func(string) bool { return false })
+	require.NoError(t, err)
+	assert.Equal(t, "b", key.ID, "an unpublished key beats no key")
+
+	_, err = pickSigningKey(map[string]*Key{"c": keys["c"]}, "", all)
+	assert.Error(t, err)
+}
+
+func TestJWKS_VerifiesSignedToken(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "2024-01")
+	writeEdKey(t, dir, "2024-02")
+
+	for _, kid := range []string{"2024-01", "2024-02"} {
+		t.Run(kid, func(t *testing.T) {
+			ks, err := Load(&config.Config{JWTKeysDir: dir, JWTSigningKeyID: kid})
+			require.NoError(t, err)
+
+			signed, err := ks.Sign(jwt.MapClaims{"sub": "1"})
+			require.NoError(t, err)
+
+			published := make(map[string]JWK)
+			for _, jwk := range ks.JWKS().Keys {
+				published[jwk.Kid] = jwk
+			}
+
+			// verify the way an outside client would, from the JWKS alone
+			_, err = jwt.Parse(signed, func(token *jwt.Token) (interface{}, error) {
+				jwk, ok := published[token.Header["kid"].(string)]
+				require.True(t, ok)
+				assert.Equal(t, token.Method.Alg(), jwk.Alg)
+				return publicKey(t, jwk), nil
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func publicKey(t *testing.T, jwk JWK) interface{} {
+	t.Helper()
+
+	decode := func(s string) []byte {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		require.NoError(t, err)
+		return b
+	}
+
+	switch jwk.Kty {
+	case "RSA":
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(decode(jwk.N)),
+			E: int(new(big.Int).SetBytes(decode(jwk.E)).Int64()),
+		}
+	case "OKP":
+		return ed25519.PublicKey(decode(jwk.X))
+	}
+	t.Fatalf("unexpected key type %q", jwk.Kty)
+	return nil
+}
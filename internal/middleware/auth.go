@@ -2,23 +2,37 @@ package middleware
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
-	"github.com/jacobpq/soccer-manager/internal/config"
 	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/keys"
 	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
 )
 
 type contextKey string
 
-const UserIDKey contextKey = "userID"
+const (
+	UserIDKey    contextKey = "userID"
+	SessionIDKey contextKey = "sessionID"
+	RoleKey      contextKey = "role"
+)
+
+func Auth(ks *keys.KeySet, sessions *repository.SessionRepository) func(http.Handler) http.Handler {
+	return authenticate(ks, sessions, models.TokenTypeAccess)
+}
+
+// MFAPending accepts only the short-lived token issued by a password login
+// on an account with 2FA enabled. It has no session behind it yet.
+func MFAPending(ks *keys.KeySet) func(http.Handler) http.Handler {
+	return authenticate(ks, nil, models.TokenTypeMFAPending)
+}
 
-func Auth(cfg *config.Config) func(http.Handler) http.Handler {
+func authenticate(ks *keys.KeySet, sessions *repository.SessionRepository, tokenType string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
@@ -34,12 +48,7 @@ func Auth(cfg *config.Config) func(http.Handler) http.Handler {
 				tokenString = strings.TrimPrefix(authHeader, "Bearer ")
 			}
 
-			token, err := jwt.ParseWithClaims(tokenString, &models.AuthClaims{}, func(token *jwt.Token) (interface{}, error) {
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-				}
-				return []byte(cfg.JWTSecret), nil
-			})
+			token, err := jwt.ParseWithClaims(tokenString, &models.AuthClaims{}, ks.Keyfunc)
 
 			if err != nil || !token.Valid {
 				api.WriteError(w, http.StatusUnauthorized, locales.T(ctx, "invalid_token"))
@@ -47,12 +56,22 @@ func Auth(cfg *config.Config) func(http.Handler) http.Handler {
 			}
 
 			if claims, ok := token.Claims.(*models.AuthClaims); ok {
-				if claims.TokenType != "access" {
+				if claims.TokenType != tokenType {
 					api.WriteError(w, http.StatusUnauthorized, locales.T(ctx, "invalid_token"))
 					return
 				}
 
+				if tokenType == models.TokenTypeAccess {
+					active, err := sessions.IsActive(ctx, claims.SessionID, claims.UserID)
+					if err != nil || !active {
+						api.WriteError(w, http.StatusUnauthorized, locales.T(ctx, "invalid_token"))
+						return
+					}
+				}
+
 				ctx = context.WithValue(ctx, UserIDKey, claims.UserID)
+				ctx = context.WithValue(ctx, SessionIDKey, claims.SessionID)
+				ctx = context.WithValue(ctx, RoleKey, claims.Role)
 				next.ServeHTTP(w, r.WithContext(ctx))
 			} else {
 				api.WriteError(w, http.StatusUnauthorized, locales.T(ctx, "invalid_token"))
@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentRequestBytes = 1 << 20
+)
+
+// IdempotencyStore reserves keys and keeps the responses given for them.
+// It is satisfied by *repository.IdempotencyRepository.
+type IdempotencyStore interface {
+	Reserve(ctx context.Context, userID int, key, requestHash string, now time.Time, ttl time.Duration) (*models.IdempotencyRecord, bool, error)
+	Complete(ctx context.Context, userID int, key string, statusCode int, contentType string, body []byte) error
+	Release(ctx context.Context, userID int, key string) error
+}
+
+// Idempotency replays the stored response when a request is retried with the
+// same Idempotency-Key. Requests without the header pass straight through.
+// It must run after Auth; keys are scoped per user.
+func Idempotency(store IdempotencyStore, ttl time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				api.WriteError(w, http.StatusBadRequest, locales.T(ctx, "invalid_idempotency_key"))
+				return
+			}
+
+			userID, ok := ctx.Value(UserIDKey).(int)
+			if !ok {
+				api.WriteError(w, http.StatusUnauthorized, locales.T(ctx, "unauthorized"))
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentRequestBytes))
+			if err != nil {
+				api.WriteError(w, http.StatusBadRequest, locales.T(ctx, "invalid_json"))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := requestFingerprint(r, body)
+
+			existing, reserved, err := store.Reserve(ctx, userID, key, hash, time.Now().UTC(), ttl)
+			if err != nil {
+				log.Printf("Failed to reserve idempotency key: %v", err)
+				api.WriteError(w, http.StatusInternalServerError, locales.T(ctx, "internal_error"))
+				return
+			}
+
+			if !reserved {
+				switch {
+				case existing.RequestHash != hash:
+					api.WriteError(w, http.StatusConflict, locales.T(ctx, "idempotency_key_reused"))
+				case existing.StatusCode == nil:
+					api.WriteError(w, http.StatusConflict, locales.T(ctx, "idempotency_request_in_progress"))
+				default:
+					if existing.ContentType != "" {
+						w.Header().Set("Content-Type", existing.ContentType)
+					}
+					w.Header().Set(IdempotentReplayedHeader, "true")
+					w.WriteHeader(*existing.StatusCode)
+					w.Write(existing.ResponseBody)
+				}
+				return
+			}
+
+			// the outcome is stored even if the client has gone away, which is
+			// exactly when a retry is coming
+			storeCtx := context.WithoutCancel(ctx)
+
+			// a panic or a server error frees the key so the client can retry
+			answered := false
+			defer func() {
+				if answered {
+					return
+				}
+				if err := store.Release(storeCtx, userID, key); err != nil {
+					log.Printf("Failed to release idempotency key: %v", err)
+				}
+			}()
+
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			// server errors are not stored so the client can retry them
+			if rec.status >= http.StatusInternalServerError {
+				return
+			}
+
+			// the action went through; keep the key even if storing the response
+			// fails, so a retry cannot repeat it
+			answered = true
+			if err := store.Complete(storeCtx, userID, key, rec.status, rec.Header().Get("Content-Type"), rec.body.Bytes()); err != nil {
+				log.Printf("Failed to store idempotent response: %v", err)
+			}
+		})
+	}
+}
+
+// requestFingerprint identifies a request by method, path and body.
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder passes the response through while keeping a copy of it.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if rr.wroteHeader {
+		return
+	}
+	rr.status = status
+	rr.wroteHeader = true
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if !rr.wroteHeader {
+		rr.WriteHeader(http.StatusOK)
+	}
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
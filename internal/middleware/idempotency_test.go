@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+// memoryStore is an in-memory IdempotencyStore.
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*models.IdempotencyRecord
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[string]*models.IdempotencyRecord)}
+}
+
+func storeKey(userID int, key string) string {
+	return fmt.Sprintf("%d:%s", userID, key)
+}
+
+func (m *memoryStore) Reserve(ctx context.Context, userID int, key, requestHash string, now time.Time, ttl time.Duration) (*models.IdempotencyRecord, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, ok := m.records[storeKey(userID, key)]; ok && now.Before(rec.ExpiresAt) {
+		return rec, false, nil
+	}
+	m.records[storeKey(userID, key)] = &models.IdempotencyRecord{
+		UserID: userID, Key: key, RequestHash: requestHash, ExpiresAt: now.Add(ttl),
+	}
+	return nil, true, nil
+}
+
+func (m *memoryStore) Complete(ctx context.Context, userID int, key string, statusCode int, contentType string, body []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rec := m.records[storeKey(userID, key)]
+	rec.StatusCode, rec.ContentType, rec.ResponseBody = &statusCode, contentType, body
+	return nil
+}
+
+func (m *memoryStore) Release(ctx context.Context, userID int, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, ok := m.records[storeKey(userID, key)]; ok && rec.StatusCode == nil {
+		delete(m.records, storeKey(userID, key))
+	}
+	return nil
+}
+
+func (m *memoryStore) held(userID int, key string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.records[storeKey(userID, key)]
+	return ok
+}
+
+func idempotentRequest(userID int, key, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/players/7/buy", strings.NewReader(body))
+	req.Header.Set(IdempotencyKeyHeader, key)
+	return req.WithContext(context.WithValue(req.Context(), UserIDKey, userID))
+}
+
+// countingHandler answers 201 with the number of times it ran.
+func countingHandler(calls *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"call":%d}`, *calls)
+	})
+}
+
+func TestIdempotency_ReplaysStoredResponse(t *testing.T) {
+	var calls int
+	h := Idempotency(newMemoryStore(), time.Hour)(countingHandler(&calls))
+
+	first := httptest.NewRecorder()
+	h.ServeHTTP(first, idempotentRequest(1, "k1", `{"a":1}`))
+
+	second := httptest.NewRecorder()
+	h.ServeHTTP(second, idempotentRequest(1, "k1", `{"a":1}`))
+
+	assert.Equal(t, 1, calls, "the handler runs once")
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, "application/json", second.Header().Get("Content-Type"))
+	assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader))
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+}
+
+func TestIdempotency_KeyReusedWithDifferentBody(t *testing.T) {
+	var calls int
+	h := Idempotency(newMemoryStore(), time.Hour)(countingHandler(&calls))
+
+	h.ServeHTTP(httptest.NewRecorder(), idempotentRequest(1, "k1", `{"a":1}`))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, idempotentRequest(1, "k1", `{"a":2}`))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotency_RequestInProgress(t *testing.T) {
+	store := newMemoryStore()
+	entered, finish := make(chan struct{}), make(chan struct{})
+
+	h := Idempotency(store, time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-finish
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		h.ServeHTTP(httptest.NewRecorder(), idempotentRequest(1, "k1", `{}`))
+	}()
+	<-entered
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, idempotentRequest(1, "k1", `{}`))
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	close(finish)
+	<-done
+}
+
+func TestIdempotency_ReleasesKeyOnServerError(t *testing.T) {
+	store := newMemoryStore()
+	fail := true
+
+	h := Idempotency(store, time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, idempotentRequest(1, "k1", `{}`))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.False(t, store.held(1, "k1"), "a server error is not stored")
+
+	fail = false
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, idempotentRequest(1, "k1", `{}`))
+	assert.Equal(t, http.StatusOK, w.Code, "the retry runs the handler again")
+	assert.True(t, store.held(1, "k1"))
+}
+
+func TestIdempotency_ReleasesKeyOnPanic(t *testing.T) {
+	store := newMemoryStore()
+
+	h := Idempotency(store, time.Hour)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	assert.Panics(t, func() {
+		h.ServeHTTP(httptest.NewRecorder(), idempotentRequest(1, "k1", `{}`))
+	})
+	assert.False(t, store.held(1, "k1"))
+}
+
+func TestIdempotency_KeysAreScopedPerUser(t *testing.T) {
+	var calls int
+	h := Idempotency(newMemoryStore(), time.Hour)(countingHandler(&calls))
+
+	h.ServeHTTP(httptest.NewRecorder(), idempotentRequest(1, "shared", `{}`))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, idempotentRequest(2, "shared", `{}`))
+
+	assert.Equal(t, 2, calls, "another user's key does not replay")
+	assert.Empty(t, w.Header().Get(IdempotentReplayedHeader))
+}
+
+func TestIdempotency_WithoutKeyPassesThrough(t *testing.T) {
+	var calls int
+	h := Idempotency(newMemoryStore(), time.Hour)(countingHandler(&calls))
+
+	for i := 0; i < 2; i++ {
+		req := idempotentRequest(1, "", `{}`)
+		req.Header.Del(IdempotencyKeyHeader)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+)
+
+// RequireRole lets the request through only if the role from the access
+// token is one of roles. It must run after Auth.
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			role, _ := ctx.Value(RoleKey).(string)
+			if !slices.Contains(roles, role) {
+				api.WriteError(w, http.StatusForbidden, locales.T(ctx, "forbidden"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+)
+
+// VerificationChecker reports whether a user has confirmed their email
+// address. It is satisfied by *repository.UserRepository.
+type VerificationChecker interface {
+	IsVerified(ctx context.Context, userID int) (bool, error)
+}
+
+// RequireVerified rejects users who have not confirmed their email address.
+// It must run after Auth and is a no-op unless REQUIRE_EMAIL_VERIFICATION is set.
+func RequireVerified(cfg *config.Config, users VerificationChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !cfg.RequireEmailVerification {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			userID, ok := ctx.Value(UserIDKey).(int)
+			if !ok {
+				api.WriteError(w, http.StatusUnauthorized, locales.T(ctx, "unauthorized"))
+				return
+			}
+
+			verified, err := users.IsVerified(ctx, userID)
+			if err != nil {
+				api.WriteError(w, http.StatusInternalServerError, locales.T(ctx, "internal_error"))
+				return
+			}
+			if !verified {
+				api.WriteError(w, http.StatusForbidden, locales.T(ctx, "email_not_verified"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
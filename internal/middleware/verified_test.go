@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+)
+
+// verifiedUsers reports the users in the set as verified.
+type verifiedUsers map[int]bool
+
+func (v verifiedUsers) IsVerified(ctx context.Context, userID int) (bool, error) {
+	return v[userID], nil
+}
+
+func TestRequireVerified(t *testing.T) {
+	users := verifiedUsers{1: true}
+
+	tests := []struct {
+		name           string
+		enabled        bool
+		userID         int
+		expectedStatus int
+	}{
+		{"gate off, unverified user", false, 2, http.StatusOK},
+		{"gate on, verified user", true, 1, http.StatusOK},
+		{"gate on, unverified user", true, 2, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{RequireEmailVerification: tt.enabled}
+			h := RequireVerified(cfg, users)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/players/7/buy", nil)
+			req = req.WithContext(context.WithValue(req.Context(), UserIDKey, tt.userID))
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/adminService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/adminService.go -destination=internal/mocks/mockAdminService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdminService is a mock of AdminService interface.
+type MockAdminService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminServiceMockRecorder is the mock recorder for MockAdminService.
+type MockAdminServiceMockRecorder struct {
+	mock *MockAdminService
+}
+
+// NewMockAdminService creates a new mock instance.
+func NewMockAdminService(ctrl *gomock.Controller) *MockAdminService {
+	mock := &MockAdminService{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService) EXPECT() *MockAdminServiceMockRecorder {
+	return m.recorder
+}
+
+// AdjustBudget mocks base method.
+func (m *MockAdminService) AdjustBudget(ctx context.Context, teamID int, amount float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustBudget", ctx, teamID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AdjustBudget indicates an expected call of AdjustBudget.
+func (mr *MockAdminServiceMockRecorder) AdjustBudget(ctx, teamID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustBudget", reflect.TypeOf((*MockAdminService)(nil).AdjustBudget), ctx, teamID, amount)
+}
+
+// BanUser mocks base method.
+func (m *MockAdminService) BanUser(ctx context.Context, adminID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanUser", ctx, adminID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockAdminServiceMockRecorder) BanUser(ctx, adminID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockAdminService)(nil).BanUser), ctx, adminID, userID)
+}
+
+// RemoveListing mocks base method.
+func (m *MockAdminService) RemoveListing(ctx context.Context, playerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveListing", ctx, playerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveListing indicates an expected call of RemoveListing.
+func (mr *MockAdminServiceMockRecorder) RemoveListing(ctx, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListing", reflect.TypeOf((*MockAdminService)(nil).RemoveListing), ctx, playerID)
+}
+
+// RenamePlayer mocks base method.
+func (m *MockAdminService) RenamePlayer(ctx context.Context, playerID int, first, last string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenamePlayer", ctx, playerID, first, last)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenamePlayer indicates an expected call of RenamePlayer.
+func (mr *MockAdminServiceMockRecorder) RenamePlayer(ctx, playerID, first, last any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenamePlayer", reflect.TypeOf((*MockAdminService)(nil).RenamePlayer), ctx, playerID, first, last)
+}
+
+// RenameTeam mocks base method.
+func (m *MockAdminService) RenameTeam(ctx context.Context, teamID int, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTeam", ctx, teamID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTeam indicates an expected call of RenameTeam.
+func (mr *MockAdminServiceMockRecorder) RenameTeam(ctx, teamID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTeam", reflect.TypeOf((*MockAdminService)(nil).RenameTeam), ctx, teamID, name)
+}
+
+// SetRole mocks base method.
+func (m *MockAdminService) SetRole(ctx context.Context, adminID, userID int, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRole", ctx, adminID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRole indicates an expected call of SetRole.
+func (mr *MockAdminServiceMockRecorder) SetRole(ctx, adminID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRole", reflect.TypeOf((*MockAdminService)(nil).SetRole), ctx, adminID, userID, role)
+}
+
+// UnbanUser mocks base method.
+func (m *MockAdminService) UnbanUser(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbanUser indicates an expected call of UnbanUser.
+func (mr *MockAdminServiceMockRecorder) UnbanUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanUser", reflect.TypeOf((*MockAdminService)(nil).UnbanUser), ctx, userID)
+}
+
+// UnlockUser mocks base method.
+func (m *MockAdminService) UnlockUser(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockAdminServiceMockRecorder) UnlockUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockAdminService)(nil).UnlockUser), ctx, userID)
+}
@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/aiClubService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/aiClubService.go -destination=internal/mocks/mockAiClubService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAIClubService is a mock of AIClubService interface.
+type MockAIClubService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAIClubServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAIClubServiceMockRecorder is the mock recorder for MockAIClubService.
+type MockAIClubServiceMockRecorder struct {
+	mock *MockAIClubService
+}
+
+// NewMockAIClubService creates a new mock instance.
+func NewMockAIClubService(ctrl *gomock.Controller) *MockAIClubService {
+	mock := &MockAIClubService{ctrl: ctrl}
+	mock.recorder = &MockAIClubServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAIClubService) EXPECT() *MockAIClubServiceMockRecorder {
+	return m.recorder
+}
+
+// EnsureClubs mocks base method.
+func (m *MockAIClubService) EnsureClubs(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureClubs", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureClubs indicates an expected call of EnsureClubs.
+func (mr *MockAIClubServiceMockRecorder) EnsureClubs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureClubs", reflect.TypeOf((*MockAIClubService)(nil).EnsureClubs), ctx)
+}
+
+// Trade mocks base method.
+func (m *MockAIClubService) Trade(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trade", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trade indicates an expected call of Trade.
+func (mr *MockAIClubServiceMockRecorder) Trade(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trade", reflect.TypeOf((*MockAIClubService)(nil).Trade), ctx)
+}
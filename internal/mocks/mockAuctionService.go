@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/auctionService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/auctionService.go -destination=internal/mocks/mockAuctionService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/jacobpq/soccer-manager/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuctionService is a mock of AuctionService interface.
+type MockAuctionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuctionServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuctionServiceMockRecorder is the mock recorder for MockAuctionService.
+type MockAuctionServiceMockRecorder struct {
+	mock *MockAuctionService
+}
+
+// NewMockAuctionService creates a new mock instance.
+func NewMockAuctionService(ctrl *gomock.Controller) *MockAuctionService {
+	mock := &MockAuctionService{ctrl: ctrl}
+	mock.recorder = &MockAuctionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuctionService) EXPECT() *MockAuctionServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelAuction mocks base method.
+func (m *MockAuctionService) CancelAuction(ctx context.Context, userID, auctionID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelAuction", ctx, userID, auctionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelAuction indicates an expected call of CancelAuction.
+func (mr *MockAuctionServiceMockRecorder) CancelAuction(ctx, userID, auctionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelAuction", reflect.TypeOf((*MockAuctionService)(nil).CancelAuction), ctx, userID, auctionID)
+}
+
+// CreateAuction mocks base method.
+func (m *MockAuctionService) CreateAuction(ctx context.Context, userID int, req *models.CreateAuctionRequest) (*models.Auction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuction", ctx, userID, req)
+	ret0, _ := ret[0].(*models.Auction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuction indicates an expected call of CreateAuction.
+func (mr *MockAuctionServiceMockRecorder) CreateAuction(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuction", reflect.TypeOf((*MockAuctionService)(nil).CreateAuction), ctx, userID, req)
+}
+
+// GetAuction mocks base method.
+func (m *MockAuctionService) GetAuction(ctx context.Context, auctionID int) (*models.Auction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuction", ctx, auctionID)
+	ret0, _ := ret[0].(*models.Auction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuction indicates an expected call of GetAuction.
+func (mr *MockAuctionServiceMockRecorder) GetAuction(ctx, auctionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuction", reflect.TypeOf((*MockAuctionService)(nil).GetAuction), ctx, auctionID)
+}
+
+// GetOpenAuctions mocks base method.
+func (m *MockAuctionService) GetOpenAuctions(ctx context.Context) ([]*models.Auction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenAuctions", ctx)
+	ret0, _ := ret[0].([]*models.Auction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenAuctions indicates an expected call of GetOpenAuctions.
+func (mr *MockAuctionServiceMockRecorder) GetOpenAuctions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenAuctions", reflect.TypeOf((*MockAuctionService)(nil).GetOpenAuctions), ctx)
+}
+
+// PlaceBid mocks base method.
+func (m *MockAuctionService) PlaceBid(ctx context.Context, userID, auctionID int, amount float64) (*models.Bid, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceBid", ctx, userID, auctionID, amount)
+	ret0, _ := ret[0].(*models.Bid)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceBid indicates an expected call of PlaceBid.
+func (mr *MockAuctionServiceMockRecorder) PlaceBid(ctx, userID, auctionID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceBid", reflect.TypeOf((*MockAuctionService)(nil).PlaceBid), ctx, userID, auctionID, amount)
+}
+
+// SettleDue mocks base method.
+func (m *MockAuctionService) SettleDue(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleDue", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SettleDue indicates an expected call of SettleDue.
+func (mr *MockAuctionServiceMockRecorder) SettleDue(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleDue", reflect.TypeOf((*MockAuctionService)(nil).SettleDue), ctx)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/calendarService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/calendarService.go -destination=internal/mocks/mockCalendarService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/jacobpq/soccer-manager/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarService is a mock of CalendarService interface.
+type MockCalendarService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarServiceMockRecorder is the mock recorder for MockCalendarService.
+type MockCalendarServiceMockRecorder struct {
+	mock *MockCalendarService
+}
+
+// NewMockCalendarService creates a new mock instance.
+func NewMockCalendarService(ctrl *gomock.Controller) *MockCalendarService {
+	mock := &MockCalendarService{ctrl: ctrl}
+	mock.recorder = &MockCalendarServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarService) EXPECT() *MockCalendarServiceMockRecorder {
+	return m.recorder
+}
+
+// CloseWindow mocks base method.
+func (m *MockCalendarService) CloseWindow(ctx context.Context, windowID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseWindow", ctx, windowID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseWindow indicates an expected call of CloseWindow.
+func (mr *MockCalendarServiceMockRecorder) CloseWindow(ctx, windowID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseWindow", reflect.TypeOf((*MockCalendarService)(nil).CloseWindow), ctx, windowID)
+}
+
+// CreateWindow mocks base method.
+func (m *MockCalendarService) CreateWindow(ctx context.Context, req *models.CreateWindowRequest) (*models.TransferWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWindow", ctx, req)
+	ret0, _ := ret[0].(*models.TransferWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWindow indicates an expected call of CreateWindow.
+func (mr *MockCalendarServiceMockRecorder) CreateWindow(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWindow", reflect.TypeOf((*MockCalendarService)(nil).CreateWindow), ctx, req)
+}
+
+// GetCalendar mocks base method.
+func (m *MockCalendarService) GetCalendar(ctx context.Context) (*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx)
+	ret0, _ := ret[0].(*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockCalendarServiceMockRecorder) GetCalendar(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockCalendarService)(nil).GetCalendar), ctx)
+}
+
+// OpenWindow mocks base method.
+func (m *MockCalendarService) OpenWindow(ctx context.Context, windowID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenWindow", ctx, windowID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenWindow indicates an expected call of OpenWindow.
+func (mr *MockCalendarServiceMockRecorder) OpenWindow(ctx, windowID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenWindow", reflect.TypeOf((*MockCalendarService)(nil).OpenWindow), ctx, windowID)
+}
+
+// RequireOpenWindow mocks base method.
+func (m *MockCalendarService) RequireOpenWindow(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequireOpenWindow", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequireOpenWindow indicates an expected call of RequireOpenWindow.
+func (mr *MockCalendarServiceMockRecorder) RequireOpenWindow(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequireOpenWindow", reflect.TypeOf((*MockCalendarService)(nil).RequireOpenWindow), ctx)
+}
+
+// SeedSeasons mocks base method.
+func (m *MockCalendarService) SeedSeasons(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedSeasons", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SeedSeasons indicates an expected call of SeedSeasons.
+func (mr *MockCalendarServiceMockRecorder) SeedSeasons(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedSeasons", reflect.TypeOf((*MockCalendarService)(nil).SeedSeasons), ctx)
+}
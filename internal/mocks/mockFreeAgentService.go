@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/freeAgentService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/freeAgentService.go -destination=internal/mocks/mockFreeAgentService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/jacobpq/soccer-manager/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFreeAgentService is a mock of FreeAgentService interface.
+type MockFreeAgentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFreeAgentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFreeAgentServiceMockRecorder is the mock recorder for MockFreeAgentService.
+type MockFreeAgentServiceMockRecorder struct {
+	mock *MockFreeAgentService
+}
+
+// NewMockFreeAgentService creates a new mock instance.
+func NewMockFreeAgentService(ctrl *gomock.Controller) *MockFreeAgentService {
+	mock := &MockFreeAgentService{ctrl: ctrl}
+	mock.recorder = &MockFreeAgentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFreeAgentService) EXPECT() *MockFreeAgentServiceMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockFreeAgentService) Generate(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockFreeAgentServiceMockRecorder) Generate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockFreeAgentService)(nil).Generate), ctx)
+}
+
+// GetFreeAgents mocks base method.
+func (m *MockFreeAgentService) GetFreeAgents(ctx context.Context, position string, limit int) ([]*models.Player, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreeAgents", ctx, position, limit)
+	ret0, _ := ret[0].([]*models.Player)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFreeAgents indicates an expected call of GetFreeAgents.
+func (mr *MockFreeAgentServiceMockRecorder) GetFreeAgents(ctx, position, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreeAgents", reflect.TypeOf((*MockFreeAgentService)(nil).GetFreeAgents), ctx, position, limit)
+}
+
+// Release mocks base method.
+func (m *MockFreeAgentService) Release(ctx context.Context, userID, playerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, userID, playerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockFreeAgentServiceMockRecorder) Release(ctx, userID, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockFreeAgentService)(nil).Release), ctx, userID, playerID)
+}
+
+// Sign mocks base method.
+func (m *MockFreeAgentService) Sign(ctx context.Context, userID, playerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", ctx, userID, playerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockFreeAgentServiceMockRecorder) Sign(ctx, userID, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockFreeAgentService)(nil).Sign), ctx, userID, playerID)
+}
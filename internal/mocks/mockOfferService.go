@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/offerService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/offerService.go -destination=internal/mocks/mockOfferService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/jacobpq/soccer-manager/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOfferService is a mock of OfferService interface.
+type MockOfferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOfferServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOfferServiceMockRecorder is the mock recorder for MockOfferService.
+type MockOfferServiceMockRecorder struct {
+	mock *MockOfferService
+}
+
+// NewMockOfferService creates a new mock instance.
+func NewMockOfferService(ctrl *gomock.Controller) *MockOfferService {
+	mock := &MockOfferService{ctrl: ctrl}
+	mock.recorder = &MockOfferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOfferService) EXPECT() *MockOfferServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptOffer mocks base method.
+func (m *MockOfferService) AcceptOffer(ctx context.Context, userID, offerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOffer", ctx, userID, offerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptOffer indicates an expected call of AcceptOffer.
+func (mr *MockOfferServiceMockRecorder) AcceptOffer(ctx, userID, offerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOffer", reflect.TypeOf((*MockOfferService)(nil).AcceptOffer), ctx, userID, offerID)
+}
+
+// CounterOffer mocks base method.
+func (m *MockOfferService) CounterOffer(ctx context.Context, userID, offerID int, amount float64) (*models.TransferOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CounterOffer", ctx, userID, offerID, amount)
+	ret0, _ := ret[0].(*models.TransferOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CounterOffer indicates an expected call of CounterOffer.
+func (mr *MockOfferServiceMockRecorder) CounterOffer(ctx, userID, offerID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CounterOffer", reflect.TypeOf((*MockOfferService)(nil).CounterOffer), ctx, userID, offerID, amount)
+}
+
+// CreateOffer mocks base method.
+func (m *MockOfferService) CreateOffer(ctx context.Context, userID, playerID int, amount float64) (*models.TransferOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOffer", ctx, userID, playerID, amount)
+	ret0, _ := ret[0].(*models.TransferOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOffer indicates an expected call of CreateOffer.
+func (mr *MockOfferServiceMockRecorder) CreateOffer(ctx, userID, playerID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOffer", reflect.TypeOf((*MockOfferService)(nil).CreateOffer), ctx, userID, playerID, amount)
+}
+
+// ExpireOffers mocks base method.
+func (m *MockOfferService) ExpireOffers(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOffers", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireOffers indicates an expected call of ExpireOffers.
+func (mr *MockOfferServiceMockRecorder) ExpireOffers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOffers", reflect.TypeOf((*MockOfferService)(nil).ExpireOffers), ctx)
+}
+
+// GetIncoming mocks base method.
+func (m *MockOfferService) GetIncoming(ctx context.Context, userID int) ([]*models.TransferOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncoming", ctx, userID)
+	ret0, _ := ret[0].([]*models.TransferOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncoming indicates an expected call of GetIncoming.
+func (mr *MockOfferServiceMockRecorder) GetIncoming(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncoming", reflect.TypeOf((*MockOfferService)(nil).GetIncoming), ctx, userID)
+}
+
+// GetOutgoing mocks base method.
+func (m *MockOfferService) GetOutgoing(ctx context.Context, userID int) ([]*models.TransferOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoing", ctx, userID)
+	ret0, _ := ret[0].([]*models.TransferOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutgoing indicates an expected call of GetOutgoing.
+func (mr *MockOfferServiceMockRecorder) GetOutgoing(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoing", reflect.TypeOf((*MockOfferService)(nil).GetOutgoing), ctx, userID)
+}
+
+// RejectOffer mocks base method.
+func (m *MockOfferService) RejectOffer(ctx context.Context, userID, offerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectOffer", ctx, userID, offerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectOffer indicates an expected call of RejectOffer.
+func (mr *MockOfferServiceMockRecorder) RejectOffer(ctx, userID, offerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectOffer", reflect.TypeOf((*MockOfferService)(nil).RejectOffer), ctx, userID, offerID)
+}
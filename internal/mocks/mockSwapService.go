@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/swapService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/swapService.go -destination=internal/mocks/mockSwapService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/jacobpq/soccer-manager/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSwapService is a mock of SwapService interface.
+type MockSwapService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSwapServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSwapServiceMockRecorder is the mock recorder for MockSwapService.
+type MockSwapServiceMockRecorder struct {
+	mock *MockSwapService
+}
+
+// NewMockSwapService creates a new mock instance.
+func NewMockSwapService(ctrl *gomock.Controller) *MockSwapService {
+	mock := &MockSwapService{ctrl: ctrl}
+	mock.recorder = &MockSwapServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSwapService) EXPECT() *MockSwapServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptSwap mocks base method.
+func (m *MockSwapService) AcceptSwap(ctx context.Context, userID, swapID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptSwap", ctx, userID, swapID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptSwap indicates an expected call of AcceptSwap.
+func (mr *MockSwapServiceMockRecorder) AcceptSwap(ctx, userID, swapID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptSwap", reflect.TypeOf((*MockSwapService)(nil).AcceptSwap), ctx, userID, swapID)
+}
+
+// CreateSwap mocks base method.
+func (m *MockSwapService) CreateSwap(ctx context.Context, userID int, req *models.CreateSwapRequest) (*models.Swap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSwap", ctx, userID, req)
+	ret0, _ := ret[0].(*models.Swap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSwap indicates an expected call of CreateSwap.
+func (mr *MockSwapServiceMockRecorder) CreateSwap(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSwap", reflect.TypeOf((*MockSwapService)(nil).CreateSwap), ctx, userID, req)
+}
+
+// ExpireSwaps mocks base method.
+func (m *MockSwapService) ExpireSwaps(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSwaps", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireSwaps indicates an expected call of ExpireSwaps.
+func (mr *MockSwapServiceMockRecorder) ExpireSwaps(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSwaps", reflect.TypeOf((*MockSwapService)(nil).ExpireSwaps), ctx)
+}
+
+// GetSwaps mocks base method.
+func (m *MockSwapService) GetSwaps(ctx context.Context, userID int) ([]*models.Swap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSwaps", ctx, userID)
+	ret0, _ := ret[0].([]*models.Swap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSwaps indicates an expected call of GetSwaps.
+func (mr *MockSwapServiceMockRecorder) GetSwaps(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwaps", reflect.TypeOf((*MockSwapService)(nil).GetSwaps), ctx, userID)
+}
+
+// RejectSwap mocks base method.
+func (m *MockSwapService) RejectSwap(ctx context.Context, userID, swapID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectSwap", ctx, userID, swapID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectSwap indicates an expected call of RejectSwap.
+func (mr *MockSwapServiceMockRecorder) RejectSwap(ctx, userID, swapID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectSwap", reflect.TypeOf((*MockSwapService)(nil).RejectSwap), ctx, userID, swapID)
+}
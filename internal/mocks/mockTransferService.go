@@ -41,6 +41,34 @@ func (m *MockTransferService) EXPECT() *MockTransferServiceMockRecorder {
 	return m.recorder
 }
 
+// AcceptLoan mocks base method.
+func (m *MockTransferService) AcceptLoan(ctx context.Context, userID, loanID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptLoan", ctx, userID, loanID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptLoan indicates an expected call of AcceptLoan.
+func (mr *MockTransferServiceMockRecorder) AcceptLoan(ctx, userID, loanID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptLoan", reflect.TypeOf((*MockTransferService)(nil).AcceptLoan), ctx, userID, loanID)
+}
+
+// BuyForTeam mocks base method.
+func (m *MockTransferService) BuyForTeam(ctx context.Context, teamID, playerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyForTeam", ctx, teamID, playerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuyForTeam indicates an expected call of BuyForTeam.
+func (mr *MockTransferServiceMockRecorder) BuyForTeam(ctx, teamID, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyForTeam", reflect.TypeOf((*MockTransferService)(nil).BuyForTeam), ctx, teamID, playerID)
+}
+
 // BuyPlayer mocks base method.
 func (m *MockTransferService) BuyPlayer(ctx context.Context, userID, playerID int) error {
 	m.ctrl.T.Helper()
@@ -55,19 +83,122 @@ func (mr *MockTransferServiceMockRecorder) BuyPlayer(ctx, userID, playerID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyPlayer", reflect.TypeOf((*MockTransferService)(nil).BuyPlayer), ctx, userID, playerID)
 }
 
+// ExpireListings mocks base method.
+func (m *MockTransferService) ExpireListings(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireListings", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireListings indicates an expected call of ExpireListings.
+func (mr *MockTransferServiceMockRecorder) ExpireListings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireListings", reflect.TypeOf((*MockTransferService)(nil).ExpireListings), ctx)
+}
+
+// GetLatestTransfers mocks base method.
+func (m *MockTransferService) GetLatestTransfers(ctx context.Context, limit int) ([]*models.TransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestTransfers", ctx, limit)
+	ret0, _ := ret[0].([]*models.TransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestTransfers indicates an expected call of GetLatestTransfers.
+func (mr *MockTransferServiceMockRecorder) GetLatestTransfers(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestTransfers", reflect.TypeOf((*MockTransferService)(nil).GetLatestTransfers), ctx, limit)
+}
+
+// GetLoans mocks base method.
+func (m *MockTransferService) GetLoans(ctx context.Context, userID int) ([]*models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoans", ctx, userID)
+	ret0, _ := ret[0].([]*models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoans indicates an expected call of GetLoans.
+func (mr *MockTransferServiceMockRecorder) GetLoans(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoans", reflect.TypeOf((*MockTransferService)(nil).GetLoans), ctx, userID)
+}
+
 // GetMarket mocks base method.
-func (m *MockTransferService) GetMarket(ctx context.Context) ([]*models.Player, error) {
+func (m *MockTransferService) GetMarket(ctx context.Context, filter *models.MarketFilter) (*models.MarketPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMarket", ctx)
-	ret0, _ := ret[0].([]*models.Player)
+	ret := m.ctrl.Call(m, "GetMarket", ctx, filter)
+	ret0, _ := ret[0].(*models.MarketPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMarket indicates an expected call of GetMarket.
-func (mr *MockTransferServiceMockRecorder) GetMarket(ctx any) *gomock.Call {
+func (mr *MockTransferServiceMockRecorder) GetMarket(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarket", reflect.TypeOf((*MockTransferService)(nil).GetMarket), ctx, filter)
+}
+
+// GetPlayer mocks base method.
+func (m *MockTransferService) GetPlayer(ctx context.Context, playerID int) (*models.Player, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayer", ctx, playerID)
+	ret0, _ := ret[0].(*models.Player)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayer indicates an expected call of GetPlayer.
+func (mr *MockTransferServiceMockRecorder) GetPlayer(ctx, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayer", reflect.TypeOf((*MockTransferService)(nil).GetPlayer), ctx, playerID)
+}
+
+// GetPlayerHistory mocks base method.
+func (m *MockTransferService) GetPlayerHistory(ctx context.Context, playerID int) ([]*models.TransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerHistory", ctx, playerID)
+	ret0, _ := ret[0].([]*models.TransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayerHistory indicates an expected call of GetPlayerHistory.
+func (mr *MockTransferServiceMockRecorder) GetPlayerHistory(ctx, playerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarket", reflect.TypeOf((*MockTransferService)(nil).GetMarket), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerHistory", reflect.TypeOf((*MockTransferService)(nil).GetPlayerHistory), ctx, playerID)
+}
+
+// GetTeamTransfers mocks base method.
+func (m *MockTransferService) GetTeamTransfers(ctx context.Context, userID int) ([]*models.TransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamTransfers", ctx, userID)
+	ret0, _ := ret[0].([]*models.TransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamTransfers indicates an expected call of GetTeamTransfers.
+func (mr *MockTransferServiceMockRecorder) GetTeamTransfers(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamTransfers", reflect.TypeOf((*MockTransferService)(nil).GetTeamTransfers), ctx, userID)
+}
+
+// ListForTeam mocks base method.
+func (m *MockTransferService) ListForTeam(ctx context.Context, teamID, playerID int, price float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForTeam", ctx, teamID, playerID, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListForTeam indicates an expected call of ListForTeam.
+func (mr *MockTransferServiceMockRecorder) ListForTeam(ctx, teamID, playerID, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForTeam", reflect.TypeOf((*MockTransferService)(nil).ListForTeam), ctx, teamID, playerID, price)
 }
 
 // ListPlayer mocks base method.
@@ -84,6 +215,20 @@ func (mr *MockTransferServiceMockRecorder) ListPlayer(ctx, userID, playerID, pri
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlayer", reflect.TypeOf((*MockTransferService)(nil).ListPlayer), ctx, userID, playerID, price)
 }
 
+// RejectLoan mocks base method.
+func (m *MockTransferService) RejectLoan(ctx context.Context, userID, loanID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectLoan", ctx, userID, loanID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectLoan indicates an expected call of RejectLoan.
+func (mr *MockTransferServiceMockRecorder) RejectLoan(ctx, userID, loanID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectLoan", reflect.TypeOf((*MockTransferService)(nil).RejectLoan), ctx, userID, loanID)
+}
+
 // RemoveFromList mocks base method.
 func (m *MockTransferService) RemoveFromList(ctx context.Context, userID, playerID int) error {
 	m.ctrl.T.Helper()
@@ -97,3 +242,60 @@ func (mr *MockTransferServiceMockRecorder) RemoveFromList(ctx, userID, playerID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromList", reflect.TypeOf((*MockTransferService)(nil).RemoveFromList), ctx, userID, playerID)
 }
+
+// RequestLoan mocks base method.
+func (m *MockTransferService) RequestLoan(ctx context.Context, userID int, req *models.LoanRequest) (*models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestLoan", ctx, userID, req)
+	ret0, _ := ret[0].(*models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestLoan indicates an expected call of RequestLoan.
+func (mr *MockTransferServiceMockRecorder) RequestLoan(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestLoan", reflect.TypeOf((*MockTransferService)(nil).RequestLoan), ctx, userID, req)
+}
+
+// ReturnLoans mocks base method.
+func (m *MockTransferService) ReturnLoans(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnLoans", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReturnLoans indicates an expected call of ReturnLoans.
+func (mr *MockTransferServiceMockRecorder) ReturnLoans(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnLoans", reflect.TypeOf((*MockTransferService)(nil).ReturnLoans), ctx)
+}
+
+// SetReleaseClause mocks base method.
+func (m *MockTransferService) SetReleaseClause(ctx context.Context, userID, playerID int, amount *float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReleaseClause", ctx, userID, playerID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReleaseClause indicates an expected call of SetReleaseClause.
+func (mr *MockTransferServiceMockRecorder) SetReleaseClause(ctx, userID, playerID, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReleaseClause", reflect.TypeOf((*MockTransferService)(nil).SetReleaseClause), ctx, userID, playerID, amount)
+}
+
+// TriggerReleaseClause mocks base method.
+func (m *MockTransferService) TriggerReleaseClause(ctx context.Context, userID, playerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerReleaseClause", ctx, userID, playerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerReleaseClause indicates an expected call of TriggerReleaseClause.
+func (mr *MockTransferServiceMockRecorder) TriggerReleaseClause(ctx, userID, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerReleaseClause", reflect.TypeOf((*MockTransferService)(nil).TriggerReleaseClause), ctx, userID, playerID)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/valuationService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/valuationService.go -destination=internal/mocks/mockValuationService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockValuationService is a mock of ValuationService interface.
+type MockValuationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockValuationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockValuationServiceMockRecorder is the mock recorder for MockValuationService.
+type MockValuationServiceMockRecorder struct {
+	mock *MockValuationService
+}
+
+// NewMockValuationService creates a new mock instance.
+func NewMockValuationService(ctrl *gomock.Controller) *MockValuationService {
+	mock := &MockValuationService{ctrl: ctrl}
+	mock.recorder = &MockValuationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValuationService) EXPECT() *MockValuationServiceMockRecorder {
+	return m.recorder
+}
+
+// Recompute mocks base method.
+func (m *MockValuationService) Recompute(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recompute", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Recompute indicates an expected call of Recompute.
+func (mr *MockValuationServiceMockRecorder) Recompute(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recompute", reflect.TypeOf((*MockValuationService)(nil).Recompute), ctx)
+}
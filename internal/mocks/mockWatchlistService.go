@@ -0,0 +1,210 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/watchlistService.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/watchlistService.go -destination=internal/mocks/mockWatchlistService.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/jacobpq/soccer-manager/internal/domain/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTransferHooks is a mock of TransferHooks interface.
+type MockTransferHooks struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferHooksMockRecorder
+	isgomock struct{}
+}
+
+// MockTransferHooksMockRecorder is the mock recorder for MockTransferHooks.
+type MockTransferHooksMockRecorder struct {
+	mock *MockTransferHooks
+}
+
+// NewMockTransferHooks creates a new mock instance.
+func NewMockTransferHooks(ctrl *gomock.Controller) *MockTransferHooks {
+	mock := &MockTransferHooks{ctrl: ctrl}
+	mock.recorder = &MockTransferHooksMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferHooks) EXPECT() *MockTransferHooksMockRecorder {
+	return m.recorder
+}
+
+// PlayerListed mocks base method.
+func (m *MockTransferHooks) PlayerListed(ctx context.Context, player *models.Player, price float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PlayerListed", ctx, player, price)
+}
+
+// PlayerListed indicates an expected call of PlayerListed.
+func (mr *MockTransferHooksMockRecorder) PlayerListed(ctx, player, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayerListed", reflect.TypeOf((*MockTransferHooks)(nil).PlayerListed), ctx, player, price)
+}
+
+// PlayerSold mocks base method.
+func (m *MockTransferHooks) PlayerSold(ctx context.Context, player *models.Player, price float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PlayerSold", ctx, player, price)
+}
+
+// PlayerSold indicates an expected call of PlayerSold.
+func (mr *MockTransferHooksMockRecorder) PlayerSold(ctx, player, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayerSold", reflect.TypeOf((*MockTransferHooks)(nil).PlayerSold), ctx, player, price)
+}
+
+// PriceDropped mocks base method.
+func (m *MockTransferHooks) PriceDropped(ctx context.Context, player *models.Player, oldPrice, newPrice float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PriceDropped", ctx, player, oldPrice, newPrice)
+}
+
+// PriceDropped indicates an expected call of PriceDropped.
+func (mr *MockTransferHooksMockRecorder) PriceDropped(ctx, player, oldPrice, newPrice any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceDropped", reflect.TypeOf((*MockTransferHooks)(nil).PriceDropped), ctx, player, oldPrice, newPrice)
+}
+
+// MockWatchlistService is a mock of WatchlistService interface.
+type MockWatchlistService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchlistServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWatchlistServiceMockRecorder is the mock recorder for MockWatchlistService.
+type MockWatchlistServiceMockRecorder struct {
+	mock *MockWatchlistService
+}
+
+// NewMockWatchlistService creates a new mock instance.
+func NewMockWatchlistService(ctrl *gomock.Controller) *MockWatchlistService {
+	mock := &MockWatchlistService{ctrl: ctrl}
+	mock.recorder = &MockWatchlistServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchlistService) EXPECT() *MockWatchlistServiceMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockWatchlistService) GetNotifications(ctx context.Context, userID int) ([]*models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID)
+	ret0, _ := ret[0].([]*models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockWatchlistServiceMockRecorder) GetNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockWatchlistService)(nil).GetNotifications), ctx, userID)
+}
+
+// GetWatchlist mocks base method.
+func (m *MockWatchlistService) GetWatchlist(ctx context.Context, userID int) ([]*models.WatchlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchlist", ctx, userID)
+	ret0, _ := ret[0].([]*models.WatchlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchlist indicates an expected call of GetWatchlist.
+func (mr *MockWatchlistServiceMockRecorder) GetWatchlist(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchlist", reflect.TypeOf((*MockWatchlistService)(nil).GetWatchlist), ctx, userID)
+}
+
+// MarkNotificationRead mocks base method.
+func (m *MockWatchlistService) MarkNotificationRead(ctx context.Context, userID, notificationID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationRead", ctx, userID, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationRead indicates an expected call of MarkNotificationRead.
+func (mr *MockWatchlistServiceMockRecorder) MarkNotificationRead(ctx, userID, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockWatchlistService)(nil).MarkNotificationRead), ctx, userID, notificationID)
+}
+
+// PlayerListed mocks base method.
+func (m *MockWatchlistService) PlayerListed(ctx context.Context, player *models.Player, price float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PlayerListed", ctx, player, price)
+}
+
+// PlayerListed indicates an expected call of PlayerListed.
+func (mr *MockWatchlistServiceMockRecorder) PlayerListed(ctx, player, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayerListed", reflect.TypeOf((*MockWatchlistService)(nil).PlayerListed), ctx, player, price)
+}
+
+// PlayerSold mocks base method.
+func (m *MockWatchlistService) PlayerSold(ctx context.Context, player *models.Player, price float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PlayerSold", ctx, player, price)
+}
+
+// PlayerSold indicates an expected call of PlayerSold.
+func (mr *MockWatchlistServiceMockRecorder) PlayerSold(ctx, player, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayerSold", reflect.TypeOf((*MockWatchlistService)(nil).PlayerSold), ctx, player, price)
+}
+
+// PriceDropped mocks base method.
+func (m *MockWatchlistService) PriceDropped(ctx context.Context, player *models.Player, oldPrice, newPrice float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PriceDropped", ctx, player, oldPrice, newPrice)
+}
+
+// PriceDropped indicates an expected call of PriceDropped.
+func (mr *MockWatchlistServiceMockRecorder) PriceDropped(ctx, player, oldPrice, newPrice any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceDropped", reflect.TypeOf((*MockWatchlistService)(nil).PriceDropped), ctx, player, oldPrice, newPrice)
+}
+
+// Unwatch mocks base method.
+func (m *MockWatchlistService) Unwatch(ctx context.Context, userID, playerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unwatch", ctx, userID, playerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unwatch indicates an expected call of Unwatch.
+func (mr *MockWatchlistServiceMockRecorder) Unwatch(ctx, userID, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unwatch", reflect.TypeOf((*MockWatchlistService)(nil).Unwatch), ctx, userID, playerID)
+}
+
+// Watch mocks base method.
+func (m *MockWatchlistService) Watch(ctx context.Context, userID, playerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, userID, playerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockWatchlistServiceMockRecorder) Watch(ctx, userID, playerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockWatchlistService)(nil).Watch), ctx, userID, playerID)
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type AuctionRepository struct{}
+
+func NewAuctionRepository() *AuctionRepository {
+	return &AuctionRepository{}
+}
+
+const auctionColumns = `
+	id, player_id, COALESCE(seller_team_id, 0), reserve_price, min_increment,
+	high_bid, high_bidder_team_id, status, ends_at, created_at`
+
+func scanAuction(row pgx.Row) (*models.Auction, error) {
+	var a models.Auction
+	err := row.Scan(
+		&a.ID, &a.PlayerID, &a.SellerTeamID, &a.ReservePrice, &a.MinIncrement,
+		&a.HighBid, &a.HighBidderTeamID, &a.Status, &a.EndsAt, &a.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *AuctionRepository) Create(ctx context.Context, tx pgx.Tx, a *models.Auction) error {
+	query := `
+		INSERT INTO auctions (player_id, seller_team_id, reserve_price, min_increment, ends_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, status, created_at`
+
+	err := tx.QueryRow(ctx, query, a.PlayerID, a.SellerTeamID, a.ReservePrice, a.MinIncrement, a.EndsAt).
+		Scan(&a.ID, &a.Status, &a.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrAuctionExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *AuctionRepository) GetByID(ctx context.Context, db *pgxpool.Pool, auctionID int) (*models.Auction, error) {
+	query := `SELECT` + auctionColumns + ` FROM auctions WHERE id = $1`
+	return scanAuction(db.QueryRow(ctx, query, auctionID))
+}
+
+// GetByIDForUpdate locks the auction row. Bids and settlement both take this
+// lock first, before any player or team rows.
+func (r *AuctionRepository) GetByIDForUpdate(ctx context.Context, tx pgx.Tx, auctionID int) (*models.Auction, error) {
+	query := `SELECT` + auctionColumns + ` FROM auctions WHERE id = $1 FOR UPDATE`
+	return scanAuction(tx.QueryRow(ctx, query, auctionID))
+}
+
+func (r *AuctionRepository) HasOpenAuction(ctx context.Context, q Querier, playerID int) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM auctions WHERE player_id = $1 AND status = 'open')`
+	err := q.QueryRow(ctx, query, playerID).Scan(&exists)
+	return exists, err
+}
+
+func (r *AuctionRepository) GetOpen(ctx context.Context, db *pgxpool.Pool) ([]*models.Auction, error) {
+	query := `
+		SELECT a.id, a.player_id, COALESCE(a.seller_team_id, 0), a.reserve_price, a.min_increment,
+			a.high_bid, a.high_bidder_team_id, a.status, a.ends_at, a.created_at,
+			p.id, COALESCE(p.team_id, 0), p.first_name, p.last_name, p.country, p.age, p.position, p.value
+		FROM auctions a
+		JOIN players p ON p.id = a.player_id
+		WHERE a.status = 'open'
+		ORDER BY a.ends_at`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	auctions := make([]*models.Auction, 0)
+	for rows.Next() {
+		var a models.Auction
+		var p models.Player
+		err := rows.Scan(
+			&a.ID, &a.PlayerID, &a.SellerTeamID, &a.ReservePrice, &a.MinIncrement,
+			&a.HighBid, &a.HighBidderTeamID, &a.Status, &a.EndsAt, &a.CreatedAt,
+			&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position, &p.Value,
+		)
+		if err != nil {
+			return nil, err
+		}
+		a.Player = &p
+		auctions = append(auctions, &a)
+	}
+	return auctions, rows.Err()
+}
+
+// GetDueIDs returns open auctions whose end time has passed.
+func (r *AuctionRepository) GetDueIDs(ctx context.Context, db *pgxpool.Pool, now time.Time) ([]int, error) {
+	query := `SELECT id FROM auctions WHERE status = 'open' AND ends_at <= $1 ORDER BY ends_at`
+
+	rows, err := db.Query(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *AuctionRepository) GetBids(ctx context.Context, db *pgxpool.Pool, auctionID int) ([]*models.Bid, error) {
+	query := `
+		SELECT id, auction_id, team_id, amount, created_at
+		FROM bids WHERE auction_id = $1
+		ORDER BY amount DESC, id`
+
+	rows, err := db.Query(ctx, query, auctionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bids := make([]*models.Bid, 0)
+	for rows.Next() {
+		var b models.Bid
+		if err := rows.Scan(&b.ID, &b.AuctionID, &b.TeamID, &b.Amount, &b.CreatedAt); err != nil {
+			return nil, err
+		}
+		bids = append(bids, &b)
+	}
+	return bids, rows.Err()
+}
+
+func (r *AuctionRepository) CreateBid(ctx context.Context, tx pgx.Tx, bid *models.Bid) error {
+	query := `
+		INSERT INTO bids (auction_id, team_id, amount)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at`
+	return tx.QueryRow(ctx, query, bid.AuctionID, bid.TeamID, bid.Amount).Scan(&bid.ID, &bid.CreatedAt)
+}
+
+func (r *AuctionRepository) SetHighBid(ctx context.Context, tx pgx.Tx, auctionID, teamID int, amount float64) error {
+	query := `UPDATE auctions SET high_bid = $2, high_bidder_team_id = $3 WHERE id = $1`
+	_, err := tx.Exec(ctx, query, auctionID, amount, teamID)
+	return err
+}
+
+func (r *AuctionRepository) SetStatus(ctx context.Context, tx pgx.Tx, auctionID int, status string) error {
+	query := `UPDATE auctions SET status = $2 WHERE id = $1`
+	_, err := tx.Exec(ctx, query, auctionID, status)
+	return err
+}
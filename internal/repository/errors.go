@@ -4,4 +4,8 @@ import "errors"
 
 var (
 	ErrDuplicateEmail = errors.New("email already exists")
+	ErrTeamNameTaken  = errors.New("team name already exists")
+	ErrAuctionExists  = errors.New("player already has an open auction")
+	ErrOfferExists    = errors.New("a pending offer for this player already exists")
+	ErrLoanExists     = errors.New("a pending loan request for this player already exists")
 )
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type IdempotencyRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewIdempotencyRepository(db *pgxpool.Pool) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// Reserve claims key for the user. An expired record is taken over. When the
+// key is already held it returns the existing record and false.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, userID int, key, requestHash string, now time.Time, ttl time.Duration) (*models.IdempotencyRecord, bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (user_id, key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= $4
+		RETURNING user_id`
+	var id int
+	err := r.db.QueryRow(ctx, query, userID, key, requestHash, now, now.Add(ttl)).Scan(&id)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+
+	var rec models.IdempotencyRecord
+	var contentType *string
+	query = `
+		SELECT user_id, key, request_hash, status_code, content_type, response_body, expires_at
+		FROM idempotency_keys WHERE user_id = $1 AND key = $2`
+	err = r.db.QueryRow(ctx, query, userID, key).Scan(
+		&rec.UserID, &rec.Key, &rec.RequestHash, &rec.StatusCode, &contentType, &rec.ResponseBody, &rec.ExpiresAt,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	if contentType != nil {
+		rec.ContentType = *contentType
+	}
+	return &rec, false, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, userID int, key string, statusCode int, contentType string, body []byte) error {
+	query := `
+		UPDATE idempotency_keys SET status_code = $3, content_type = $4, response_body = $5
+		WHERE user_id = $1 AND key = $2`
+	_, err := r.db.Exec(ctx, query, userID, key, statusCode, contentType, body)
+	return err
+}
+
+// Release drops an unfinished reservation so the client can retry with the same key.
+func (r *IdempotencyRepository) Release(ctx context.Context, userID int, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND status_code IS NULL`
+	_, err := r.db.Exec(ctx, query, userID, key)
+	return err
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+	tag, err := r.db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type LoanRepository struct{}
+
+func NewLoanRepository() *LoanRepository {
+	return &LoanRepository{}
+}
+
+func (r *LoanRepository) Create(ctx context.Context, tx pgx.Tx, l *models.Loan) error {
+	query := `
+		INSERT INTO loans (player_id, lender_team_id, borrower_team_id, fee, days, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, status, created_at`
+
+	err := tx.QueryRow(ctx, query,
+		l.PlayerID, l.LenderTeamID, l.BorrowerTeamID, l.Fee, l.Days, l.ExpiresAt,
+	).Scan(&l.ID, &l.Status, &l.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrLoanExists
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByIDForUpdate locks the loan. Accepting and returning take this lock
+// before the player and team rows.
+func (r *LoanRepository) GetByIDForUpdate(ctx context.Context, tx pgx.Tx, loanID int) (*models.Loan, error) {
+	var l models.Loan
+	query := `
+		SELECT id, player_id, COALESCE(lender_team_id, 0), COALESCE(borrower_team_id, 0), fee, days, status,
+			expires_at, starts_at, ends_at, created_at, responded_at, returned_at
+		FROM loans WHERE id = $1
+		FOR UPDATE`
+	err := tx.QueryRow(ctx, query, loanID).Scan(
+		&l.ID, &l.PlayerID, &l.LenderTeamID, &l.BorrowerTeamID, &l.Fee, &l.Days, &l.Status,
+		&l.ExpiresAt, &l.StartsAt, &l.EndsAt, &l.CreatedAt, &l.RespondedAt, &l.ReturnedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// GetByTeamID returns the team's pending and active loans on either side.
+func (r *LoanRepository) GetByTeamID(ctx context.Context, db *pgxpool.Pool, teamID int) ([]*models.Loan, error) {
+	query := `
+		SELECT l.id, l.player_id, COALESCE(l.lender_team_id, 0), COALESCE(l.borrower_team_id, 0), l.fee, l.days, l.status,
+			l.expires_at, l.starts_at, l.ends_at, l.created_at, l.responded_at, l.returned_at,
+			p.id, COALESCE(p.team_id, 0), p.first_name, p.last_name, p.country, p.age, p.position, p.value
+		FROM loans l
+		JOIN players p ON p.id = l.player_id
+		WHERE l.status IN ('pending', 'active')
+			AND (l.lender_team_id = $1 OR l.borrower_team_id = $1)
+		ORDER BY l.created_at DESC`
+
+	rows, err := db.Query(ctx, query, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	loans := make([]*models.Loan, 0)
+	for rows.Next() {
+		var l models.Loan
+		var p models.Player
+		err := rows.Scan(
+			&l.ID, &l.PlayerID, &l.LenderTeamID, &l.BorrowerTeamID, &l.Fee, &l.Days, &l.Status,
+			&l.ExpiresAt, &l.StartsAt, &l.EndsAt, &l.CreatedAt, &l.RespondedAt, &l.ReturnedAt,
+			&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position, &p.Value,
+		)
+		if err != nil {
+			return nil, err
+		}
+		l.Player = &p
+		loans = append(loans, &l)
+	}
+	return loans, rows.Err()
+}
+
+func (r *LoanRepository) HasActiveLoan(ctx context.Context, q Querier, playerID int) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM loans WHERE player_id = $1 AND status = 'active')`
+	err := q.QueryRow(ctx, query, playerID).Scan(&exists)
+	return exists, err
+}
+
+func (r *LoanRepository) Activate(ctx context.Context, tx pgx.Tx, loanID int, startsAt, endsAt time.Time) error {
+	query := `
+		UPDATE loans SET status = 'active', starts_at = $2, ends_at = $3, responded_at = $2
+		WHERE id = $1`
+	_, err := tx.Exec(ctx, query, loanID, startsAt, endsAt)
+	return err
+}
+
+func (r *LoanRepository) SetStatus(ctx context.Context, tx pgx.Tx, loanID int, status string, now time.Time) error {
+	query := `UPDATE loans SET status = $2, responded_at = $3 WHERE id = $1`
+	_, err := tx.Exec(ctx, query, loanID, status, now)
+	return err
+}
+
+func (r *LoanRepository) MarkReturned(ctx context.Context, tx pgx.Tx, loanID int, now time.Time) error {
+	query := `UPDATE loans SET status = 'returned', returned_at = $2 WHERE id = $1`
+	_, err := tx.Exec(ctx, query, loanID, now)
+	return err
+}
+
+// ReturnBorrowedBy ends the team's active loans early and moves each player
+// back to the lender, as a team leaving the game cannot keep them.
+func (r *LoanRepository) ReturnBorrowedBy(ctx context.Context, tx pgx.Tx, teamID int, now time.Time) error {
+	query := `
+		WITH returned AS (
+			UPDATE loans SET status = 'returned', returned_at = $2
+			WHERE borrower_team_id = $1 AND status = 'active'
+			RETURNING player_id, lender_team_id
+		)
+		UPDATE players p
+		SET team_id = r.lender_team_id, on_transfer_list = false, market_value = 0,
+			listed_at = NULL, listing_expires_at = NULL, delisted_at = NULL
+		FROM returned r
+		WHERE p.id = r.player_id`
+	_, err := tx.Exec(ctx, query, teamID, now)
+	return err
+}
+
+// CancelPendingForPlayer closes the other requests once the player is loaned out.
+func (r *LoanRepository) CancelPendingForPlayer(ctx context.Context, tx pgx.Tx, playerID int, now time.Time) error {
+	query := `
+		UPDATE loans SET status = 'cancelled', responded_at = $2
+		WHERE player_id = $1 AND status = 'pending'`
+	_, err := tx.Exec(ctx, query, playerID, now)
+	return err
+}
+
+// GetDueIDs returns active loans whose last game day has passed.
+func (r *LoanRepository) GetDueIDs(ctx context.Context, db *pgxpool.Pool, now time.Time) ([]int, error) {
+	query := `SELECT id FROM loans WHERE status = 'active' AND ends_at <= $1 ORDER BY ends_at`
+
+	rows, err := db.Query(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *LoanRepository) ExpirePending(ctx context.Context, db *pgxpool.Pool, now time.Time) (int64, error) {
+	query := `UPDATE loans SET status = 'expired' WHERE status = 'pending' AND expires_at <= $1`
+	tag, err := db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
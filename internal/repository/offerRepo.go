@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type OfferRepository struct{}
+
+func NewOfferRepository() *OfferRepository {
+	return &OfferRepository{}
+}
+
+func (r *OfferRepository) Create(ctx context.Context, tx pgx.Tx, o *models.TransferOffer) error {
+	query := `
+		INSERT INTO transfer_offers (player_id, buyer_team_id, seller_team_id, proposed_by_team_id, parent_offer_id, amount, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, status, created_at`
+
+	err := tx.QueryRow(ctx, query,
+		o.PlayerID, o.BuyerTeamID, o.SellerTeamID, o.ProposedByTeamID, o.ParentOfferID, o.Amount, o.ExpiresAt,
+	).Scan(&o.ID, &o.Status, &o.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrOfferExists
+		}
+		return err
+	}
+	return nil
+}
+
+// GetByIDForUpdate locks the offer. Accepting takes this lock before the
+// player and team rows.
+func (r *OfferRepository) GetByIDForUpdate(ctx context.Context, tx pgx.Tx, offerID int) (*models.TransferOffer, error) {
+	var o models.TransferOffer
+	query := `
+		SELECT id, player_id, buyer_team_id, seller_team_id, proposed_by_team_id, parent_offer_id,
+			amount, status, expires_at, created_at, responded_at
+		FROM transfer_offers WHERE id = $1
+		FOR UPDATE`
+	err := tx.QueryRow(ctx, query, offerID).Scan(
+		&o.ID, &o.PlayerID, &o.BuyerTeamID, &o.SellerTeamID, &o.ProposedByTeamID, &o.ParentOfferID,
+		&o.Amount, &o.Status, &o.ExpiresAt, &o.CreatedAt, &o.RespondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// GetIncoming returns live offers the team has to answer. Offers for players
+// that have since moved to another team are left out.
+func (r *OfferRepository) GetIncoming(ctx context.Context, db *pgxpool.Pool, teamID int, now time.Time) ([]*models.TransferOffer, error) {
+	query := `
+		WHERE o.status = 'pending' AND o.expires_at > $2 AND p.team_id = o.seller_team_id
+			AND o.proposed_by_team_id <> $1
+			AND (o.buyer_team_id = $1 OR o.seller_team_id = $1)`
+	return r.list(ctx, db, query, teamID, now)
+}
+
+// GetOutgoing returns live offers the team proposed.
+func (r *OfferRepository) GetOutgoing(ctx context.Context, db *pgxpool.Pool, teamID int, now time.Time) ([]*models.TransferOffer, error) {
+	query := `
+		WHERE o.status = 'pending' AND o.expires_at > $2 AND p.team_id = o.seller_team_id
+			AND o.proposed_by_team_id = $1`
+	return r.list(ctx, db, query, teamID, now)
+}
+
+func (r *OfferRepository) list(ctx context.Context, db *pgxpool.Pool, where string, args ...any) ([]*models.TransferOffer, error) {
+	query := `
+		SELECT o.id, o.player_id, o.buyer_team_id, o.seller_team_id, o.proposed_by_team_id, o.parent_offer_id,
+			o.amount, o.status, o.expires_at, o.created_at, o.responded_at,
+			p.id, COALESCE(p.team_id, 0), p.first_name, p.last_name, p.country, p.age, p.position, p.value
+		FROM transfer_offers o
+		JOIN players p ON p.id = o.player_id` + where + `
+		ORDER BY o.created_at DESC`
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	offers := make([]*models.TransferOffer, 0)
+	for rows.Next() {
+		var o models.TransferOffer
+		var p models.Player
+		err := rows.Scan(
+			&o.ID, &o.PlayerID, &o.BuyerTeamID, &o.SellerTeamID, &o.ProposedByTeamID, &o.ParentOfferID,
+			&o.Amount, &o.Status, &o.ExpiresAt, &o.CreatedAt, &o.RespondedAt,
+			&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position, &p.Value,
+		)
+		if err != nil {
+			return nil, err
+		}
+		o.Player = &p
+		offers = append(offers, &o)
+	}
+	return offers, rows.Err()
+}
+
+func (r *OfferRepository) SetStatus(ctx context.Context, tx pgx.Tx, offerID int, status string, now time.Time) error {
+	query := `UPDATE transfer_offers SET status = $2, responded_at = $3 WHERE id = $1`
+	_, err := tx.Exec(ctx, query, offerID, status, now)
+	return err
+}
+
+// CancelPendingForPlayer closes every other open offer once the player has moved.
+func (r *OfferRepository) CancelPendingForPlayer(ctx context.Context, tx pgx.Tx, playerID int, now time.Time) error {
+	query := `
+		UPDATE transfer_offers SET status = 'cancelled', responded_at = $2
+		WHERE player_id = $1 AND status = 'pending'`
+	_, err := tx.Exec(ctx, query, playerID, now)
+	return err
+}
+
+func (r *OfferRepository) ExpirePending(ctx context.Context, db *pgxpool.Pool, now time.Time) (int64, error) {
+	query := `UPDATE transfer_offers SET status = 'expired' WHERE status = 'pending' AND expires_at <= $1`
+	tag, err := db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PasswordResetRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPasswordResetRepository(db *pgxpool.Pool) *PasswordResetRepository {
+	return &PasswordResetRepository{db: db}
+}
+
+func (r *PasswordResetRepository) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time) error {
+	query := `
+		INSERT INTO password_reset_tokens (user_id, token_hash, expires_at) 
+		VALUES ($1, $2, $3)`
+	_, err := r.db.Exec(ctx, query, userID, tokenHash, expiresAt)
+	return err
+}
+
+// Consume marks an unused, unexpired token as used and returns its owner.
+// It returns pgx.ErrNoRows when the token cannot be used.
+func (r *PasswordResetRepository) Consume(ctx context.Context, tx pgx.Tx, tokenHash string) (int, error) {
+	var userID int
+	query := `
+		UPDATE password_reset_tokens SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING user_id`
+	err := tx.QueryRow(ctx, query, tokenHash, time.Now()).Scan(&userID)
+	return userID, err
+}
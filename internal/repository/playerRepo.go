@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -17,8 +20,8 @@ func NewPlayerRepository() *PlayerRepository {
 
 func (r *PlayerRepository) CreateBatch(ctx context.Context, tx pgx.Tx, players []*models.Player) error {
 	query := `
-		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, base_value, market_value)
+		VALUES (NULLIF($1, 0), $2, $3, $4, $5, $6, $7, $7, $8)`
 
 	for _, p := range players {
 		_, err := tx.Exec(ctx, query,
@@ -33,7 +36,8 @@ func (r *PlayerRepository) CreateBatch(ctx context.Context, tx pgx.Tx, players [
 
 func (r *PlayerRepository) GetByTeamID(ctx context.Context, db *pgxpool.Pool, teamID int) ([]*models.Player, error) {
 	query := `
-		SELECT id, team_id, first_name, last_name, country, age, position, value, market_value, on_transfer_list 
+		SELECT id, team_id, first_name, last_name, country, age, position, value, market_value, on_transfer_list,
+			release_clause, listed_at, listing_expires_at
 		FROM players WHERE team_id = $1`
 
 	rows, err := db.Query(ctx, query, teamID)
@@ -51,6 +55,7 @@ func (r *PlayerRepository) GetByTeamID(ctx context.Context, db *pgxpool.Pool, te
 		err := rows.Scan(
 			&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country,
 			&p.Age, &p.Position, &p.Value, &marketValue, &p.OnTransferList,
+			&p.ReleaseClause, &p.ListedAt, &p.ListingExpiresAt,
 		)
 		if err != nil {
 			return nil, err
@@ -63,53 +68,242 @@ func (r *PlayerRepository) GetByTeamID(ctx context.Context, db *pgxpool.Pool, te
 	return players, nil
 }
 
-func (r *PlayerRepository) UpdateMarketStatus(ctx context.Context, db *pgxpool.Pool, playerID int, price float64, onList bool) error {
-	query := `UPDATE players SET market_value = $1, on_transfer_list = $2 WHERE id = $3`
-	_, err := db.Exec(ctx, query, price, onList, playerID)
+// List puts the player on the market. Changing the price of a listed player
+// keeps its original listing time and expiry. Callers hold the player's row
+// lock inside tx.
+func (r *PlayerRepository) List(ctx context.Context, tx pgx.Tx, playerID int, price float64, now, expiresAt time.Time) error {
+	query := `
+		UPDATE players
+		SET market_value = $2, on_transfer_list = true,
+			listed_at = CASE WHEN on_transfer_list THEN listed_at ELSE $3 END,
+			listing_expires_at = CASE WHEN on_transfer_list THEN listing_expires_at ELSE $4 END
+		WHERE id = $1`
+	_, err := tx.Exec(ctx, query, playerID, price, now, expiresAt)
 	return err
 }
 
-func (r *PlayerRepository) GetMarketPlayers(ctx context.Context, db *pgxpool.Pool) ([]*models.Player, error) {
+// Delist takes the player off the market and starts the relist cooldown.
+func (r *PlayerRepository) Delist(ctx context.Context, db *pgxpool.Pool, playerID int, now time.Time) error {
 	query := `
-		SELECT id, team_id, first_name, last_name, country, age, position, value, market_value 
-		FROM players WHERE on_transfer_list = true`
+		UPDATE players
+		SET market_value = 0, on_transfer_list = false,
+			listed_at = NULL, listing_expires_at = NULL, delisted_at = $2
+		WHERE id = $1`
+	_, err := db.Exec(ctx, query, playerID, now)
+	return err
+}
 
-	rows, err := db.Query(ctx, query)
+// DelistExpired takes every listing past its expiry off the market.
+func (r *PlayerRepository) DelistExpired(ctx context.Context, db *pgxpool.Pool, now time.Time) (int64, error) {
+	query := `
+		UPDATE players
+		SET market_value = 0, on_transfer_list = false,
+			listed_at = NULL, listing_expires_at = NULL, delisted_at = $1
+		WHERE on_transfer_list = true AND listing_expires_at <= $1`
+	tag, err := db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+var marketSortColumns = map[string]string{
+	models.MarketSortPrice:    "market_value",
+	models.MarketSortValue:    "value",
+	models.MarketSortAge:      "age",
+	models.MarketSortListedAt: "listed_at",
+}
+
+// SearchMarket returns one page of listed players using keyset pagination on
+// (sort column, id). It reads limit+1 rows so the caller can tell whether
+// another page exists.
+func (r *PlayerRepository) SearchMarket(ctx context.Context, db *pgxpool.Pool, f *models.MarketFilter) ([]*models.Player, error) {
+	column := marketSortColumns[f.Sort]
+	where := []string{"on_transfer_list = true", "team_id IS NOT NULL"}
+	args := []any{}
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+
+	if f.Position != "" {
+		add("position = $%d", f.Position)
+	}
+	if f.Country != "" {
+		add("country = $%d", f.Country)
+	}
+	if f.MinAge != nil {
+		add("age >= $%d", *f.MinAge)
+	}
+	if f.MaxAge != nil {
+		add("age <= $%d", *f.MaxAge)
+	}
+	if f.MinPrice != nil {
+		add("market_value >= $%d", *f.MinPrice)
+	}
+	if f.MaxPrice != nil {
+		add("market_value <= $%d", *f.MaxPrice)
+	}
+	if f.MinValue != nil {
+		add("value >= $%d", *f.MinValue)
+	}
+	if f.MaxValue != nil {
+		add("value <= $%d", *f.MaxValue)
+	}
+	if f.SellerTeamID != nil {
+		add("team_id = $%d", *f.SellerTeamID)
+	}
+	if f.ListedSince != nil {
+		add("listed_at >= $%d", *f.ListedSince)
+	}
+
+	direction, cmp := "ASC", ">"
+	if f.Desc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if f.Cursor != nil {
+		key, err := f.Cursor.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, key, f.Cursor.ID)
+		where = append(where, fmt.Sprintf("(%s, id) %s ($%d, $%d)", column, cmp, len(args)-1, len(args)))
+	}
+
+	args = append(args, f.Limit+1)
+	query := fmt.Sprintf(`
+		SELECT id, team_id, first_name, last_name, country, age, position, value, market_value, listed_at, listing_expires_at
+		FROM players
+		WHERE %s
+		ORDER BY %s %s, id %s
+		LIMIT $%d`, strings.Join(where, " AND "), column, direction, direction, len(args))
+
+	rows, err := db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	players := make([]*models.Player, 0)
+	players := make([]*models.Player, 0, f.Limit+1)
 
 	for rows.Next() {
 		var p models.Player
-		err := rows.Scan(&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position, &p.Value, &p.MarketPrice)
+		err := rows.Scan(&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position, &p.Value, &p.MarketPrice, &p.ListedAt, &p.ListingExpiresAt)
 		if err != nil {
 			return nil, err
 		}
 		p.OnTransferList = true
 		players = append(players, &p)
 	}
-	return players, nil
+	return players, rows.Err()
 }
 
 func (r *PlayerRepository) GetByID(ctx context.Context, db *pgxpool.Pool, playerID int) (*models.Player, error) {
 	var p models.Player
-	query := `SELECT id, team_id, value, market_value, on_transfer_list FROM players WHERE id = $1`
-	err := db.QueryRow(ctx, query, playerID).Scan(&p.ID, &p.TeamID, &p.Value, &p.MarketPrice, &p.OnTransferList)
+	query := `
+		SELECT id, COALESCE(team_id, 0), first_name, last_name, country, age, position, value, base_value, market_value, on_transfer_list,
+			release_clause, listed_at, listing_expires_at, delisted_at
+		FROM players WHERE id = $1`
+	err := db.QueryRow(ctx, query, playerID).Scan(
+		&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country,
+		&p.Age, &p.Position, &p.Value, &p.BaseValue, &p.MarketPrice, &p.OnTransferList,
+		&p.ReleaseClause, &p.ListedAt, &p.ListingExpiresAt, &p.DelistedAt,
+	)
 	if err != nil {
 		return nil, err
 	}
 	return &p, nil
 }
 
-func (r *PlayerRepository) TransferOwnership(ctx context.Context, tx pgx.Tx, playerID int, newTeamID int, newValue float64) error {
+// GetByIDForUpdate reads the player inside tx and locks the row until the
+// transaction ends, so concurrent transfers of the same player serialize.
+func (r *PlayerRepository) GetByIDForUpdate(ctx context.Context, tx pgx.Tx, playerID int) (*models.Player, error) {
+	var p models.Player
+	query := `
+		SELECT id, COALESCE(team_id, 0), first_name, last_name, country, age, position, value, base_value, market_value, on_transfer_list,
+			release_clause, listed_at, listing_expires_at, delisted_at
+		FROM players WHERE id = $1
+		FOR UPDATE`
+	err := tx.QueryRow(ctx, query, playerID).Scan(
+		&p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country,
+		&p.Age, &p.Position, &p.Value, &p.BaseValue, &p.MarketPrice, &p.OnTransferList,
+		&p.ReleaseClause, &p.ListedAt, &p.ListingExpiresAt, &p.DelistedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PlayerRepository) TransferOwnership(ctx context.Context, tx pgx.Tx, playerID int, newTeamID int, newValue, baseValue float64) error {
 	query := `
         UPDATE players 
-        SET team_id = $1, value = $2, on_transfer_list = false, market_value = 0 
-        WHERE id = $3`
-	_, err := tx.Exec(ctx, query, newTeamID, newValue, playerID)
+        SET team_id = $1, value = $2, base_value = $3, on_transfer_list = false, market_value = 0,
+            release_clause = NULL, listed_at = NULL, listing_expires_at = NULL, delisted_at = NULL
+        WHERE id = $4`
+	_, err := tx.Exec(ctx, query, newTeamID, newValue, baseValue, playerID)
+	return err
+}
+
+// GetAllForValuation reads what the valuation job needs for every player.
+func (r *PlayerRepository) GetAllForValuation(ctx context.Context, db *pgxpool.Pool) ([]*models.Player, error) {
+	query := `SELECT id, age, position, value, base_value FROM players ORDER BY id`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	players := make([]*models.Player, 0)
+	for rows.Next() {
+		var p models.Player
+		if err := rows.Scan(&p.ID, &p.Age, &p.Position, &p.Value, &p.BaseValue); err != nil {
+			return nil, err
+		}
+		players = append(players, &p)
+	}
+	return players, rows.Err()
+}
+
+// UpdateValues sets values[i] on the player ids[i] in one statement. A player
+// whose value or base value no longer matches olds[i] or bases[i] was
+// transferred since the values were computed, so he keeps the value the
+// transfer gave him.
+func (r *PlayerRepository) UpdateValues(ctx context.Context, db *pgxpool.Pool, ids []int, values, olds, bases []float64) error {
+	query := `
+		UPDATE players p SET value = v.value
+		FROM unnest($1::int[], $2::numeric[], $3::numeric[], $4::numeric[]) AS v(id, value, old_value, base_value)
+		WHERE p.id = v.id AND p.value = v.old_value AND p.base_value = v.base_value`
+	_, err := db.Exec(ctx, query, ids, values, olds, bases)
+	return err
+}
+
+// CountByTeamID counts the squad inside tx. Callers lock the team row first
+// so the count cannot change under them.
+func (r *PlayerRepository) CountByTeamID(ctx context.Context, tx pgx.Tx, teamID int) (int, error) {
+	var n int
+	err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM players WHERE team_id = $1`, teamID).Scan(&n)
+	return n, err
+}
+
+// MoveToTeam changes the player's team without a sale, as loans do. A nil
+// team sends the player to the free-agent pool.
+func (r *PlayerRepository) MoveToTeam(ctx context.Context, tx pgx.Tx, playerID int, teamID *int) error {
+	query := `
+		UPDATE players
+		SET team_id = $2, on_transfer_list = false, market_value = 0,
+			listed_at = NULL, listing_expires_at = NULL, delisted_at = NULL
+		WHERE id = $1`
+	_, err := tx.Exec(ctx, query, playerID, teamID)
+	return err
+}
+
+// SetReleaseClause sets the clause, or clears it when amount is nil.
+func (r *PlayerRepository) SetReleaseClause(ctx context.Context, db *pgxpool.Pool, playerID int, amount *float64) error {
+	_, err := db.Exec(ctx, `UPDATE players SET release_clause = $2 WHERE id = $1`, playerID, amount)
 	return err
 }
 
@@ -118,3 +312,57 @@ func (r *PlayerRepository) UpdateDetails(ctx context.Context, db *pgxpool.Pool,
 	_, err := db.Exec(ctx, query, first, last, country, playerID)
 	return err
 }
+
+// GetFreeAgents returns the players without a team, most valuable first.
+func (r *PlayerRepository) GetFreeAgents(ctx context.Context, db *pgxpool.Pool, position string, limit int) ([]*models.Player, error) {
+	query := `
+		SELECT id, first_name, last_name, country, age, position, value
+		FROM players
+		WHERE team_id IS NULL AND ($1 = '' OR position = $1)
+		ORDER BY value DESC, id
+		LIMIT $2`
+
+	rows, err := db.Query(ctx, query, position, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	players := make([]*models.Player, 0)
+	for rows.Next() {
+		var p models.Player
+		if err := rows.Scan(&p.ID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position, &p.Value); err != nil {
+			return nil, err
+		}
+		players = append(players, &p)
+	}
+	return players, rows.Err()
+}
+
+func (r *PlayerRepository) CountFreeAgents(ctx context.Context, db *pgxpool.Pool) (int, error) {
+	var n int
+	err := db.QueryRow(ctx, `SELECT COUNT(*) FROM players WHERE team_id IS NULL`).Scan(&n)
+	return n, err
+}
+
+// Release moves one player into the free-agent pool.
+func (r *PlayerRepository) Release(ctx context.Context, tx pgx.Tx, playerID int) error {
+	query := `
+		UPDATE players
+		SET team_id = NULL, on_transfer_list = false, market_value = 0, release_clause = NULL,
+			listed_at = NULL, listing_expires_at = NULL, delisted_at = NULL
+		WHERE id = $1`
+	_, err := tx.Exec(ctx, query, playerID)
+	return err
+}
+
+// ReleaseTeamPlayers moves every player of the team into the free-agent pool.
+func (r *PlayerRepository) ReleaseTeamPlayers(ctx context.Context, tx pgx.Tx, teamID int) error {
+	query := `
+		UPDATE players 
+		SET team_id = NULL, on_transfer_list = false, market_value = 0, release_clause = NULL,
+			listed_at = NULL, listing_expires_at = NULL, delisted_at = NULL
+		WHERE team_id = $1`
+	_, err := tx.Exec(ctx, query, teamID)
+	return err
+}
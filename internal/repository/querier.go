@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Querier is satisfied by both the pool and a transaction. Checks that take
+// one run under the caller's row locks when called with the open tx.
+type Querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
@@ -19,11 +19,12 @@ func NewSessionRepository(db *pgxpool.Pool) *SessionRepository {
 
 func (r *SessionRepository) Create(ctx context.Context, session *models.Session) error {
 	query := `
-		INSERT INTO sessions (user_id, refresh_token, refresh_expires_at) 
-		VALUES ($1, $2, $3)`
-	_, err := r.db.Exec(ctx, query,
-		session.UserID, session.RefreshToken, session.RefreshExpiresAt)
-	return err
+		INSERT INTO sessions (user_id, refresh_token, refresh_expires_at, user_agent, ip_address) 
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`
+	return r.db.QueryRow(ctx, query,
+		session.UserID, session.RefreshToken, session.RefreshExpiresAt,
+		session.UserAgent, session.IPAddress).Scan(&session.ID)
 }
 
 func (r *SessionRepository) GetByRefreshToken(ctx context.Context, refreshToken string) (*models.Session, error) {
@@ -41,3 +42,96 @@ func (r *SessionRepository) GetByRefreshToken(ctx context.Context, refreshToken
 	}
 	return &s, nil
 }
+
+func (r *SessionRepository) IsActive(ctx context.Context, sessionID, userID int) (bool, error) {
+	var active bool
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM sessions WHERE id = $1 AND user_id = $2 AND refresh_expires_at > $3
+		)`
+	err := r.db.QueryRow(ctx, query, sessionID, userID, time.Now()).Scan(&active)
+	return active, err
+}
+
+func (r *SessionRepository) Delete(ctx context.Context, sessionID, userID int) (bool, error) {
+	query := `DELETE FROM sessions WHERE id = $1 AND user_id = $2`
+	tag, err := r.db.Exec(ctx, query, sessionID, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *SessionRepository) DeleteByUserID(ctx context.Context, userID int) error {
+	query := `DELETE FROM sessions WHERE user_id = $1`
+	_, err := r.db.Exec(ctx, query, userID)
+	return err
+}
+
+func (r *SessionRepository) DeleteOthers(ctx context.Context, userID, keepSessionID int) error {
+	query := `DELETE FROM sessions WHERE user_id = $1 AND id <> $2`
+	_, err := r.db.Exec(ctx, query, userID, keepSessionID)
+	return err
+}
+
+// Rotate swaps the session's refresh token and remembers the old one so a
+// later replay of it can be detected. It returns false when oldToken is no
+// longer the current token, i.e. another refresh won the race.
+func (r *SessionRepository) Rotate(ctx context.Context, sessionID int, oldToken, newToken string, client models.ClientInfo) (bool, error) {
+	query := `
+		WITH rotated AS (
+			UPDATE sessions
+			SET refresh_token = $3, last_refreshed_at = $4, user_agent = $5, ip_address = $6
+			WHERE id = $1 AND refresh_token = $2
+			RETURNING id
+		)
+		INSERT INTO rotated_refresh_tokens (refresh_token, session_id)
+		SELECT $2, id FROM rotated`
+	tag, err := r.db.Exec(ctx, query, sessionID, oldToken, newToken, time.Now(), client.UserAgent, client.IPAddress)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *SessionRepository) GetByRotatedToken(ctx context.Context, refreshToken string) (*models.Session, error) {
+	var s models.Session
+
+	query := `
+		SELECT s.id, s.user_id, s.refresh_token, s.refresh_expires_at
+		FROM rotated_refresh_tokens rt
+		JOIN sessions s ON s.id = rt.session_id
+		WHERE rt.refresh_token = $1`
+
+	err := r.db.QueryRow(ctx, query, refreshToken).Scan(
+		&s.ID, &s.UserID, &s.RefreshToken, &s.RefreshExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *SessionRepository) ListByUserID(ctx context.Context, userID int) ([]*models.SessionInfo, error) {
+	query := `
+		SELECT id, COALESCE(user_agent, ''), COALESCE(ip_address, ''), created_at, last_refreshed_at
+		FROM sessions WHERE user_id = $1 AND refresh_expires_at > $2
+		ORDER BY COALESCE(last_refreshed_at, created_at) DESC`
+
+	rows, err := r.db.Query(ctx, query, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*models.SessionInfo, 0)
+
+	for rows.Next() {
+		var s models.SessionInfo
+		if err := rows.Scan(&s.ID, &s.UserAgent, &s.IPAddress, &s.CreatedAt, &s.LastRefreshedAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &s)
+	}
+	return sessions, rows.Err()
+}
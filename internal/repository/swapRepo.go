@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type SwapRepository struct{}
+
+func NewSwapRepository() *SwapRepository {
+	return &SwapRepository{}
+}
+
+// Create inserts the swap and every player in it.
+func (r *SwapRepository) Create(ctx context.Context, tx pgx.Tx, s *models.Swap) error {
+	query := `
+		INSERT INTO swaps (proposer_team_id, recipient_team_id, cash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, status, created_at`
+
+	err := tx.QueryRow(ctx, query, s.ProposerTeamID, s.RecipientTeamID, s.Cash, s.ExpiresAt).
+		Scan(&s.ID, &s.Status, &s.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range s.Players {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO swap_players (swap_id, player_id, from_team_id) VALUES ($1, $2, $3)`,
+			s.ID, p.PlayerID, p.FromTeamID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetByIDForUpdate locks the swap and reads its players. Accepting takes
+// this lock before the player and team rows.
+func (r *SwapRepository) GetByIDForUpdate(ctx context.Context, tx pgx.Tx, swapID int) (*models.Swap, error) {
+	var s models.Swap
+	query := `
+		SELECT id, proposer_team_id, recipient_team_id, cash, status, expires_at, created_at, responded_at
+		FROM swaps WHERE id = $1
+		FOR UPDATE`
+	err := tx.QueryRow(ctx, query, swapID).Scan(
+		&s.ID, &s.ProposerTeamID, &s.RecipientTeamID, &s.Cash, &s.Status, &s.ExpiresAt, &s.CreatedAt, &s.RespondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT player_id, from_team_id FROM swap_players WHERE swap_id = $1 ORDER BY player_id`, swapID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	s.Players = make([]*models.SwapPlayer, 0)
+	for rows.Next() {
+		var p models.SwapPlayer
+		if err := rows.Scan(&p.PlayerID, &p.FromTeamID); err != nil {
+			return nil, err
+		}
+		s.Players = append(s.Players, &p)
+	}
+	return &s, rows.Err()
+}
+
+// GetPendingByTeamID returns live swaps the team proposed or has to answer.
+func (r *SwapRepository) GetPendingByTeamID(ctx context.Context, db *pgxpool.Pool, teamID int, now time.Time) ([]*models.Swap, error) {
+	query := `
+		SELECT s.id, s.proposer_team_id, s.recipient_team_id, s.cash, s.status, s.expires_at, s.created_at, s.responded_at,
+			sp.from_team_id, p.id, COALESCE(p.team_id, 0), p.first_name, p.last_name, p.country, p.age, p.position, p.value
+		FROM swaps s
+		JOIN swap_players sp ON sp.swap_id = s.id
+		JOIN players p ON p.id = sp.player_id
+		WHERE s.status = 'pending' AND s.expires_at > $2
+			AND (s.proposer_team_id = $1 OR s.recipient_team_id = $1)
+		ORDER BY s.created_at DESC, s.id DESC, p.id`
+
+	rows, err := db.Query(ctx, query, teamID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	swaps := make([]*models.Swap, 0)
+	var current *models.Swap
+	for rows.Next() {
+		var s models.Swap
+		var p models.Player
+		var fromTeamID int
+		err := rows.Scan(
+			&s.ID, &s.ProposerTeamID, &s.RecipientTeamID, &s.Cash, &s.Status, &s.ExpiresAt, &s.CreatedAt, &s.RespondedAt,
+			&fromTeamID, &p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position, &p.Value,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil || current.ID != s.ID {
+			current = &s
+			swaps = append(swaps, current)
+		}
+		current.Players = append(current.Players, &models.SwapPlayer{PlayerID: p.ID, FromTeamID: fromTeamID, Player: &p})
+	}
+	return swaps, rows.Err()
+}
+
+func (r *SwapRepository) SetStatus(ctx context.Context, tx pgx.Tx, swapID int, status string, now time.Time) error {
+	query := `UPDATE swaps SET status = $2, responded_at = $3 WHERE id = $1`
+	_, err := tx.Exec(ctx, query, swapID, status, now)
+	return err
+}
+
+func (r *SwapRepository) ExpirePending(ctx context.Context, db *pgxpool.Pool, now time.Time) (int64, error) {
+	query := `UPDATE swaps SET status = 'expired' WHERE status = 'pending' AND expires_at <= $1`
+	tag, err := db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/jacobpq/soccer-manager/internal/domain/models"
@@ -17,24 +19,92 @@ func NewTeamRepository() *TeamRepository {
 
 func (r *TeamRepository) Create(ctx context.Context, tx pgx.Tx, team *models.Team) error {
 	query := `
-		INSERT INTO teams (user_id, name, country, budget) 
-		VALUES ($1, $2, $3, $4) 
+		INSERT INTO teams (user_id, name, country, budget, ai_strategy) 
+		VALUES (NULLIF($1, 0), $2, $3, $4, NULLIF($5, '')) 
 		RETURNING id`
 
-	err := tx.QueryRow(ctx, query, team.UserID, team.Name, team.Country, team.Budget).Scan(&team.ID)
-	return err
+	err := tx.QueryRow(ctx, query, team.UserID, team.Name, team.Country, team.Budget, team.AIStrategy).Scan(&team.ID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "teams_name_key" {
+			return ErrTeamNameTaken
+		}
+		return err
+	}
+	return nil
 }
 
 func (r *TeamRepository) GetByUserID(ctx context.Context, db *pgxpool.Pool, userID int) (*models.Team, error) {
 	var team models.Team
-	query := `SELECT id, user_id, name, country, budget FROM teams WHERE user_id = $1`
-	err := db.QueryRow(ctx, query, userID).Scan(&team.ID, &team.UserID, &team.Name, &team.Country, &team.Budget)
+	query := `SELECT id, COALESCE(user_id, 0), name, country, budget, COALESCE(ai_strategy, '') FROM teams WHERE user_id = $1`
+	err := db.QueryRow(ctx, query, userID).Scan(&team.ID, &team.UserID, &team.Name, &team.Country, &team.Budget, &team.AIStrategy)
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+func (r *TeamRepository) GetByID(ctx context.Context, db *pgxpool.Pool, teamID int) (*models.Team, error) {
+	var team models.Team
+	query := `SELECT id, COALESCE(user_id, 0), name, country, budget, COALESCE(ai_strategy, '') FROM teams WHERE id = $1`
+	err := db.QueryRow(ctx, query, teamID).Scan(&team.ID, &team.UserID, &team.Name, &team.Country, &team.Budget, &team.AIStrategy)
 	if err != nil {
 		return nil, err
 	}
 	return &team, nil
 }
 
+// GetByIDsForUpdate locks the given teams in id order. Always locking in the
+// same order keeps two transfers between the same teams from deadlocking.
+func (r *TeamRepository) GetByIDsForUpdate(ctx context.Context, tx pgx.Tx, teamIDs ...int) (map[int]*models.Team, error) {
+	query := `
+		SELECT id, COALESCE(user_id, 0), name, country, budget, COALESCE(ai_strategy, '') FROM teams
+		WHERE id = ANY($1)
+		ORDER BY id
+		FOR UPDATE`
+
+	rows, err := tx.Query(ctx, query, teamIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make(map[int]*models.Team, len(teamIDs))
+	for rows.Next() {
+		var team models.Team
+		if err := rows.Scan(&team.ID, &team.UserID, &team.Name, &team.Country, &team.Budget, &team.AIStrategy); err != nil {
+			return nil, err
+		}
+		teams[team.ID] = &team
+	}
+	return teams, rows.Err()
+}
+
+// GetAIClubs returns the computer-controlled teams in the order they were
+// created.
+func (r *TeamRepository) GetAIClubs(ctx context.Context, db *pgxpool.Pool) ([]*models.Team, error) {
+	query := `
+		SELECT id, name, country, budget, ai_strategy FROM teams
+		WHERE ai_strategy IS NOT NULL
+		ORDER BY id`
+
+	rows, err := db.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*models.Team
+	for rows.Next() {
+		var team models.Team
+		if err := rows.Scan(&team.ID, &team.Name, &team.Country, &team.Budget, &team.AIStrategy); err != nil {
+			return nil, err
+		}
+		teams = append(teams, &team)
+	}
+	return teams, rows.Err()
+}
+
 func (r *TeamRepository) UpdateBudget(ctx context.Context, tx pgx.Tx, teamID int, amount float64) error {
 	query := `UPDATE teams SET budget = budget + $1 WHERE id = $2`
 	_, err := tx.Exec(ctx, query, amount, teamID)
@@ -46,3 +116,9 @@ func (r *TeamRepository) UpdateDetails(ctx context.Context, db *pgxpool.Pool, te
 	_, err := db.Exec(ctx, query, name, country, teamID)
 	return err
 }
+
+func (r *TeamRepository) Delete(ctx context.Context, tx pgx.Tx, teamID int) error {
+	query := `DELETE FROM teams WHERE id = $1`
+	_, err := tx.Exec(ctx, query, teamID)
+	return err
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type TransferRepository struct{}
+
+func NewTransferRepository() *TransferRepository {
+	return &TransferRepository{}
+}
+
+func (r *TransferRepository) Create(ctx context.Context, tx pgx.Tx, t *models.TransferRecord) error {
+	query := `
+		INSERT INTO transfers (player_id, seller_team_id, buyer_team_id, price, value_before, value_after, kind)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+	return tx.QueryRow(ctx, query,
+		t.PlayerID, t.SellerTeamID, t.BuyerTeamID, t.Price, t.ValueBefore, t.ValueAfter, t.Kind,
+	).Scan(&t.ID, &t.CreatedAt)
+}
+
+func (r *TransferRepository) GetByPlayerID(ctx context.Context, db *pgxpool.Pool, playerID int) ([]*models.TransferRecord, error) {
+	return r.list(ctx, db, `WHERE t.player_id = $1 ORDER BY t.created_at DESC, t.id DESC`, playerID)
+}
+
+// GetByTeamID returns every deal the team was on either side of.
+func (r *TransferRepository) GetByTeamID(ctx context.Context, db *pgxpool.Pool, teamID int) ([]*models.TransferRecord, error) {
+	return r.list(ctx, db, `WHERE t.seller_team_id = $1 OR t.buyer_team_id = $1 ORDER BY t.created_at DESC, t.id DESC`, teamID)
+}
+
+func (r *TransferRepository) GetLatest(ctx context.Context, db *pgxpool.Pool, limit int) ([]*models.TransferRecord, error) {
+	return r.list(ctx, db, `ORDER BY t.created_at DESC, t.id DESC LIMIT $1`, limit)
+}
+
+// CountByPositionSince counts the transfers per player position since the
+// given time. It is the market demand the valuation job works with, so
+// players released for nothing are left out.
+func (r *TransferRepository) CountByPositionSince(ctx context.Context, db *pgxpool.Pool, since time.Time) (map[string]int, error) {
+	query := `
+		SELECT p.position, COUNT(*)
+		FROM transfers t
+		JOIN players p ON p.id = t.player_id
+		WHERE t.created_at >= $1 AND t.kind <> 'release'
+		GROUP BY p.position`
+
+	rows, err := db.Query(ctx, query, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var position string
+		var n int
+		if err := rows.Scan(&position, &n); err != nil {
+			return nil, err
+		}
+		counts[position] = n
+	}
+	return counts, rows.Err()
+}
+
+// CountPositionSince is CountByPositionSince for a single position, read
+// inside a transfer's transaction.
+func (r *TransferRepository) CountPositionSince(ctx context.Context, tx pgx.Tx, position string, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM transfers t
+		JOIN players p ON p.id = t.player_id
+		WHERE t.created_at >= $1 AND p.position = $2 AND t.kind <> 'release'`
+
+	var n int
+	err := tx.QueryRow(ctx, query, since, position).Scan(&n)
+	return n, err
+}
+
+func (r *TransferRepository) list(ctx context.Context, db *pgxpool.Pool, clause string, args ...any) ([]*models.TransferRecord, error) {
+	query := `
+		SELECT t.id, t.player_id, COALESCE(p.first_name, '') || ' ' || COALESCE(p.last_name, ''),
+			t.seller_team_id, COALESCE(s.name, ''), t.buyer_team_id, COALESCE(b.name, ''),
+			t.price, t.value_before, t.value_after, t.kind, t.created_at
+		FROM transfers t
+		JOIN players p ON p.id = t.player_id
+		LEFT JOIN teams s ON s.id = t.seller_team_id
+		LEFT JOIN teams b ON b.id = t.buyer_team_id
+		` + clause
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]*models.TransferRecord, 0)
+	for rows.Next() {
+		var t models.TransferRecord
+		err := rows.Scan(
+			&t.ID, &t.PlayerID, &t.PlayerName,
+			&t.SellerTeamID, &t.SellerTeamName, &t.BuyerTeamID, &t.BuyerTeamName,
+			&t.Price, &t.ValueBefore, &t.ValueAfter, &t.Kind, &t.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &t)
+	}
+	return records, rows.Err()
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -39,7 +40,110 @@ func (r *UserRepository) Create(ctx context.Context, tx pgx.Tx, user *models.Use
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
 
-	query := `SELECT id, email, password_hash FROM users WHERE email = $1`
-	err := r.db.QueryRow(ctx, query, email).Scan(&user.ID, &user.Email, &user.Password)
+	query := `
+		SELECT id, email, password_hash, role, verified_at, banned_at, COALESCE(totp_secret, ''), totp_enabled_at
+		FROM users WHERE email = $1`
+	err := r.db.QueryRow(ctx, query, email).Scan(
+		&user.ID, &user.Email, &user.Password, &user.Role, &user.VerifiedAt, &user.BannedAt,
+		&user.TOTPSecret, &user.TOTPEnabledAt,
+	)
 	return &user, err
 }
+
+func (r *UserRepository) GetByID(ctx context.Context, userID int) (*models.User, error) {
+	var user models.User
+
+	query := `
+		SELECT id, email, password_hash, role, verified_at, banned_at, COALESCE(totp_secret, ''), totp_enabled_at
+		FROM users WHERE id = $1`
+	err := r.db.QueryRow(ctx, query, userID).Scan(
+		&user.ID, &user.Email, &user.Password, &user.Role, &user.VerifiedAt, &user.BannedAt,
+		&user.TOTPSecret, &user.TOTPEnabledAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *UserRepository) UpdatePassword(ctx context.Context, tx pgx.Tx, userID int, passwordHash string) error {
+	query := `UPDATE users SET password_hash = $1 WHERE id = $2`
+	_, err := tx.Exec(ctx, query, passwordHash, userID)
+	return err
+}
+
+func (r *UserRepository) MarkVerified(ctx context.Context, userID int) error {
+	query := `UPDATE users SET verified_at = $1 WHERE id = $2 AND verified_at IS NULL`
+	_, err := r.db.Exec(ctx, query, time.Now(), userID)
+	return err
+}
+
+func (r *UserRepository) IsVerified(ctx context.Context, userID int) (bool, error) {
+	var verified bool
+	query := `SELECT verified_at IS NOT NULL FROM users WHERE id = $1`
+	err := r.db.QueryRow(ctx, query, userID).Scan(&verified)
+	return verified, err
+}
+
+func (r *UserRepository) UpdateRole(ctx context.Context, userID int, role string) (bool, error) {
+	query := `UPDATE users SET role = $1 WHERE id = $2`
+	tag, err := r.db.Exec(ctx, query, role, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// SetBanned bans the user when banned is true and lifts the ban otherwise.
+func (r *UserRepository) SetBanned(ctx context.Context, userID int, banned bool) (bool, error) {
+	var bannedAt *time.Time
+	if banned {
+		now := time.Now()
+		bannedAt = &now
+	}
+
+	query := `UPDATE users SET banned_at = $1 WHERE id = $2`
+	tag, err := r.db.Exec(ctx, query, bannedAt, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// SetPendingTOTPSecret stores a secret that is not enforced until EnableTOTP.
+func (r *UserRepository) SetPendingTOTPSecret(ctx context.Context, userID int, secret string) error {
+	query := `UPDATE users SET totp_secret = $1, totp_enabled_at = NULL, totp_last_step = NULL WHERE id = $2`
+	_, err := r.db.Exec(ctx, query, secret, userID)
+	return err
+}
+
+func (r *UserRepository) EnableTOTP(ctx context.Context, tx pgx.Tx, userID int) error {
+	query := `UPDATE users SET totp_enabled_at = $1 WHERE id = $2`
+	_, err := tx.Exec(ctx, query, time.Now(), userID)
+	return err
+}
+
+func (r *UserRepository) DisableTOTP(ctx context.Context, tx pgx.Tx, userID int) error {
+	query := `UPDATE users SET totp_secret = NULL, totp_enabled_at = NULL, totp_last_step = NULL WHERE id = $1`
+	_, err := tx.Exec(ctx, query, userID)
+	return err
+}
+
+// UseTOTPStep records step as used and returns false if it, or a later step,
+// was already used, so each code works only once.
+func (r *UserRepository) UseTOTPStep(ctx context.Context, userID int, step int64) (bool, error) {
+	query := `
+		UPDATE users SET totp_last_step = $2
+		WHERE id = $1 AND (totp_last_step IS NULL OR totp_last_step < $2)`
+	tag, err := r.db.Exec(ctx, query, userID, step)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, tx pgx.Tx, userID int) error {
+	query := `DELETE FROM users WHERE id = $1`
+	_, err := tx.Exec(ctx, query, userID)
+	return err
+}
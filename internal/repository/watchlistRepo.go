@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type WatchlistRepository struct{}
+
+func NewWatchlistRepository() *WatchlistRepository {
+	return &WatchlistRepository{}
+}
+
+// Add watches the player. Watching a player twice is not an error.
+func (r *WatchlistRepository) Add(ctx context.Context, db *pgxpool.Pool, userID, playerID int) error {
+	query := `INSERT INTO watchlist (user_id, player_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	_, err := db.Exec(ctx, query, userID, playerID)
+	return err
+}
+
+func (r *WatchlistRepository) Remove(ctx context.Context, db *pgxpool.Pool, userID, playerID int) (int64, error) {
+	tag, err := db.Exec(ctx, `DELETE FROM watchlist WHERE user_id = $1 AND player_id = $2`, userID, playerID)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *WatchlistRepository) Count(ctx context.Context, db *pgxpool.Pool, userID int) (int, error) {
+	var n int
+	err := db.QueryRow(ctx, `SELECT COUNT(*) FROM watchlist WHERE user_id = $1`, userID).Scan(&n)
+	return n, err
+}
+
+// GetByUserID returns the watched players with their current team, market
+// status and price.
+func (r *WatchlistRepository) GetByUserID(ctx context.Context, db *pgxpool.Pool, userID int) ([]*models.WatchlistEntry, error) {
+	query := `
+		SELECT w.created_at, p.id, COALESCE(p.team_id, 0), p.first_name, p.last_name, p.country, p.age, p.position,
+			p.value, p.market_value, p.on_transfer_list, p.release_clause, COALESCE(t.name, ''),
+			EXISTS (SELECT 1 FROM loans l WHERE l.player_id = p.id AND l.status = 'active')
+		FROM watchlist w
+		JOIN players p ON p.id = w.player_id
+		LEFT JOIN teams t ON t.id = p.team_id
+		WHERE w.user_id = $1
+		ORDER BY w.created_at DESC`
+
+	rows, err := db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*models.WatchlistEntry, 0)
+	for rows.Next() {
+		var e models.WatchlistEntry
+		var p models.Player
+		var marketValue *float64
+		var onLoan bool
+		err := rows.Scan(
+			&e.AddedAt, &p.ID, &p.TeamID, &p.FirstName, &p.LastName, &p.Country, &p.Age, &p.Position,
+			&p.Value, &marketValue, &p.OnTransferList, &p.ReleaseClause, &e.TeamName, &onLoan,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.TeamID == 0:
+			e.Status = models.WatchStatusFreeAgent
+		case onLoan:
+			e.Status = models.WatchStatusOnLoan
+		case p.OnTransferList:
+			e.Status = models.WatchStatusListed
+			e.Price = marketValue
+		default:
+			e.Status = models.WatchStatusNotListed
+		}
+		if marketValue != nil {
+			p.MarketPrice = *marketValue
+		}
+
+		e.Player = &p
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// NotifyWatchers writes one notification for every user watching the player.
+func (r *WatchlistRepository) NotifyWatchers(ctx context.Context, db *pgxpool.Pool, playerID int, kind string, price, oldPrice *float64) error {
+	query := `
+		INSERT INTO notifications (user_id, player_id, kind, price, old_price)
+		SELECT user_id, player_id, $2, $3, $4 FROM watchlist WHERE player_id = $1`
+	_, err := db.Exec(ctx, query, playerID, kind, price, oldPrice)
+	return err
+}
+
+func (r *WatchlistRepository) GetNotifications(ctx context.Context, db *pgxpool.Pool, userID, limit int) ([]*models.Notification, error) {
+	query := `
+		SELECT n.id, n.player_id, COALESCE(p.first_name, '') || ' ' || COALESCE(p.last_name, ''),
+			n.kind, n.price, n.old_price, n.read_at, n.created_at
+		FROM notifications n
+		JOIN players p ON p.id = n.player_id
+		WHERE n.user_id = $1
+		ORDER BY n.created_at DESC, n.id DESC
+		LIMIT $2`
+
+	rows, err := db.Query(ctx, query, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := make([]*models.Notification, 0)
+	for rows.Next() {
+		var n models.Notification
+		err := rows.Scan(&n.ID, &n.PlayerID, &n.PlayerName, &n.Kind, &n.Price, &n.OldPrice, &n.ReadAt, &n.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, &n)
+	}
+	return notifications, rows.Err()
+}
+
+func (r *WatchlistRepository) MarkNotificationRead(ctx context.Context, db *pgxpool.Pool, userID, notificationID int, now time.Time) (int64, error) {
+	query := `UPDATE notifications SET read_at = COALESCE(read_at, $3) WHERE id = $1 AND user_id = $2`
+	tag, err := db.Exec(ctx, query, notificationID, userID, now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+type WindowRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewWindowRepository(db *pgxpool.Pool) *WindowRepository {
+	return &WindowRepository{db: db}
+}
+
+func (r *WindowRepository) Create(ctx context.Context, w *models.TransferWindow) error {
+	query := `
+		INSERT INTO transfer_windows (name, starts_at, ends_at)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at`
+	return r.db.QueryRow(ctx, query, w.Name, w.StartsAt, w.EndsAt).Scan(&w.ID, &w.CreatedAt)
+}
+
+// EnsureSeason inserts a recurring window once; later calls with the same
+// season key leave any admin changes in place.
+func (r *WindowRepository) EnsureSeason(ctx context.Context, w *models.TransferWindow) error {
+	query := `
+		INSERT INTO transfer_windows (name, season_key, starts_at, ends_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (season_key) DO NOTHING`
+	_, err := r.db.Exec(ctx, query, w.Name, w.SeasonKey, w.StartsAt, w.EndsAt)
+	return err
+}
+
+func (r *WindowRepository) GetByID(ctx context.Context, windowID int) (*models.TransferWindow, error) {
+	query := `SELECT id, name, season_key, starts_at, ends_at, created_at FROM transfer_windows WHERE id = $1`
+	return scanWindow(r.db.QueryRow(ctx, query, windowID))
+}
+
+// GetCurrent returns the open window that closes last, or nil.
+func (r *WindowRepository) GetCurrent(ctx context.Context, now time.Time) (*models.TransferWindow, error) {
+	query := `
+		SELECT id, name, season_key, starts_at, ends_at, created_at FROM transfer_windows
+		WHERE starts_at <= $1 AND ends_at > $1
+		ORDER BY ends_at DESC
+		LIMIT 1`
+	w, err := scanWindow(r.db.QueryRow(ctx, query, now))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return w, err
+}
+
+func (r *WindowRepository) GetUpcoming(ctx context.Context, now time.Time, limit int) ([]*models.TransferWindow, error) {
+	query := `
+		SELECT id, name, season_key, starts_at, ends_at, created_at FROM transfer_windows
+		WHERE starts_at > $1 AND ends_at > starts_at
+		ORDER BY starts_at
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	windows := make([]*models.TransferWindow, 0)
+	for rows.Next() {
+		w, err := scanWindow(rows)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, w)
+	}
+	return windows, rows.Err()
+}
+
+func (r *WindowRepository) UpdateDates(ctx context.Context, windowID int, startsAt, endsAt time.Time) error {
+	query := `UPDATE transfer_windows SET starts_at = $2, ends_at = $3 WHERE id = $1`
+	_, err := r.db.Exec(ctx, query, windowID, startsAt, endsAt)
+	return err
+}
+
+func scanWindow(row pgx.Row) (*models.TransferWindow, error) {
+	var w models.TransferWindow
+	if err := row.Scan(&w.ID, &w.Name, &w.SeasonKey, &w.StartsAt, &w.EndsAt, &w.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
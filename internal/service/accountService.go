@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+type AccountService interface {
+	Export(ctx context.Context, userID int) (*models.AccountExport, error)
+	Delete(ctx context.Context, userID int, password string) error
+}
+
+type accountService struct {
+	db           *pgxpool.Pool
+	userRepo     *repository.UserRepository
+	teamRepo     *repository.TeamRepository
+	playerRepo   *repository.PlayerRepository
+	sessionRepo  *repository.SessionRepository
+	transferRepo *repository.TransferRepository
+	loanRepo     *repository.LoanRepository
+	now          func() time.Time
+}
+
+func NewAccountService(db *pgxpool.Pool, u *repository.UserRepository, t *repository.TeamRepository, p *repository.PlayerRepository, s *repository.SessionRepository, tr *repository.TransferRepository, l *repository.LoanRepository) AccountService {
+	return &accountService{db: db, userRepo: u, teamRepo: t, playerRepo: p, sessionRepo: s, transferRepo: tr, loanRepo: l, now: utcNow}
+}
+
+func (s *accountService) Export(ctx context.Context, userID int) (*models.AccountExport, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+	user.Password = ""
+
+	sessions, err := s.sessionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &models.AccountExport{
+		ExportedAt:       time.Now(),
+		Profile:          user,
+		Sessions:         sessions,
+		Squad:            make([]*models.Player, 0),
+		TransferListings: make([]*models.Player, 0),
+		Transfers:        make([]*models.TransferRecord, 0),
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return export, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	export.Team = team
+
+	export.Squad, err = s.playerRepo.GetByTeamID(ctx, s.db, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range export.Squad {
+		export.SquadValue += p.Value
+		if p.OnTransferList {
+			export.TransferListings = append(export.TransferListings, p)
+		}
+	}
+
+	export.Transfers, err = s.transferRepo.GetByTeamID(ctx, s.db, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// Delete removes the user and their team. The squad is not deleted but
+// released into the free-agent pool so other managers can still sign them.
+// Players the team has on loan go back to their lenders first.
+func (s *accountService) Delete(ctx context.Context, userID int, password string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "wrong_password"))
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if team != nil {
+		if err := s.loanRepo.ReturnBorrowedBy(ctx, tx, team.ID, s.now()); err != nil {
+			return err
+		}
+		if err := s.playerRepo.ReleaseTeamPlayers(ctx, tx, team.ID); err != nil {
+			return err
+		}
+		if err := s.teamRepo.Delete(ctx, tx, team.ID); err != nil {
+			return err
+		}
+	}
+
+	// sessions, reset tokens and recovery codes cascade with the user
+	if err := s.userRepo.Delete(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+	"github.com/jacobpq/soccer-manager/internal/throttle"
+)
+
+type AdminService interface {
+	AdjustBudget(ctx context.Context, teamID int, amount float64) error
+	RemoveListing(ctx context.Context, playerID int) error
+	RenameTeam(ctx context.Context, teamID int, name string) error
+	RenamePlayer(ctx context.Context, playerID int, first, last string) error
+	SetRole(ctx context.Context, adminID, userID int, role string) error
+	BanUser(ctx context.Context, adminID, userID int) error
+	UnbanUser(ctx context.Context, userID int) error
+	UnlockUser(ctx context.Context, userID int) error
+}
+
+type adminService struct {
+	db          *pgxpool.Pool
+	userRepo    *repository.UserRepository
+	teamRepo    *repository.TeamRepository
+	playerRepo  *repository.PlayerRepository
+	sessionRepo *repository.SessionRepository
+	limiter     *throttle.Limiter
+}
+
+func NewAdminService(db *pgxpool.Pool, u *repository.UserRepository, t *repository.TeamRepository, p *repository.PlayerRepository, s *repository.SessionRepository, l *throttle.Limiter) AdminService {
+	return &adminService{db: db, userRepo: u, teamRepo: t, playerRepo: p, sessionRepo: s, limiter: l}
+}
+
+func (s *adminService) AdjustBudget(ctx context.Context, teamID int, amount float64) error {
+	team, err := s.teamRepo.GetByID(ctx, s.db, teamID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	if team.Budget+amount < 0 {
+		return api.ErrBadRequest(locales.T(ctx, "budget_negative"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.teamRepo.UpdateBudget(ctx, tx, teamID, amount); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *adminService) RemoveListing(ctx context.Context, playerID int) error {
+	player, err := s.playerRepo.GetByID(ctx, s.db, playerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	if !player.OnTransferList {
+		return api.ErrNotFound(locales.T(ctx, "player_not_for_sale"))
+	}
+
+	return s.playerRepo.Delist(ctx, s.db, playerID, time.Now().UTC())
+}
+
+func (s *adminService) RenameTeam(ctx context.Context, teamID int, name string) error {
+	team, err := s.teamRepo.GetByID(ctx, s.db, teamID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	return s.teamRepo.UpdateDetails(ctx, s.db, team.ID, name, team.Country)
+}
+
+func (s *adminService) RenamePlayer(ctx context.Context, playerID int, first, last string) error {
+	player, err := s.playerRepo.GetByID(ctx, s.db, playerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	return s.playerRepo.UpdateDetails(ctx, s.db, player.ID, first, last, player.Country)
+}
+
+// SetRole changes the user's role and ends their sessions, since the role
+// travels in the access token until it is refreshed. Admins cannot change
+// their own role, so the last admin cannot lock everyone out.
+func (s *adminService) SetRole(ctx context.Context, adminID, userID int, role string) error {
+	if !models.IsValidRole(role) {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_role"))
+	}
+	if adminID == userID {
+		return api.ErrBadRequest(locales.T(ctx, "cannot_change_own_role"))
+	}
+
+	updated, err := s.userRepo.UpdateRole(ctx, userID, role)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	return s.sessionRepo.DeleteByUserID(ctx, userID)
+}
+
+func (s *adminService) BanUser(ctx context.Context, adminID, userID int) error {
+	if adminID == userID {
+		return api.ErrBadRequest(locales.T(ctx, "cannot_ban_self"))
+	}
+
+	updated, err := s.userRepo.SetBanned(ctx, userID, true)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	// dropping the sessions invalidates every token the user still holds
+	return s.sessionRepo.DeleteByUserID(ctx, userID)
+}
+
+func (s *adminService) UnbanUser(ctx context.Context, userID int) error {
+	updated, err := s.userRepo.SetBanned(ctx, userID, false)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+	return nil
+}
+
+func (s *adminService) UnlockUser(ctx context.Context, userID int) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	return s.limiter.Unlock(ctx, strings.ToLower(user.Email))
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/aiclub"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+// aiMarketPageSize is how many listings of a position an AI club looks at
+// before deciding what to buy.
+const aiMarketPageSize = 50
+
+// aiNameAttempts is how many names a new AI club tries before giving up,
+// since managers can register any name first.
+const aiNameAttempts = 10
+
+type AIClubService interface {
+	EnsureClubs(ctx context.Context) error
+	Trade(ctx context.Context) error
+}
+
+type aiClubService struct {
+	db         *pgxpool.Pool
+	teamRepo   *repository.TeamRepository
+	playerRepo *repository.PlayerRepository
+	transfers  TransferService
+	strategies []aiclub.Strategy
+	cfg        *config.Config
+	rand       *rand.Rand
+}
+
+// NewAIClubService sets up the clubs named in AI_CLUB_STRATEGIES. src drives
+// every random choice the clubs make, so a fixed seed replays the same
+// market moves.
+func NewAIClubService(db *pgxpool.Pool, t *repository.TeamRepository, p *repository.PlayerRepository, ts TransferService, src rand.Source, cfg *config.Config) (AIClubService, error) {
+	strategies, err := aiclub.ParseStrategies(cfg.AIClubStrategies)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aiClubService{
+		db:         db,
+		teamRepo:   t,
+		playerRepo: p,
+		transfers:  ts,
+		strategies: strategies,
+		cfg:        cfg,
+		rand:       rand.New(src),
+	}, nil
+}
+
+// EnsureClubs creates AI clubs with generated squads until there are
+// AI_CLUBS of them. Strategies are handed out in turn. Existing clubs keep
+// theirs.
+func (s *aiClubService) EnsureClubs(ctx context.Context) error {
+	clubs, err := s.teamRepo.GetAIClubs(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	for i := len(clubs); i < s.cfg.AIClubs; i++ {
+		err := s.createClub(ctx, i)
+		for attempt := 1; errors.Is(err, repository.ErrTeamNameTaken) && attempt < aiNameAttempts; attempt++ {
+			err = s.createClub(ctx, i)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create AI club: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *aiClubService) createClub(ctx context.Context, n int) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	team := &models.Team{
+		Name:       fmt.Sprintf("%s AI %d", repository.LastNames[s.rand.Intn(len(repository.LastNames))], n+1),
+		Country:    repository.Countries[s.rand.Intn(len(repository.Countries))],
+		Budget:     s.cfg.AIClubBudget,
+		AIStrategy: s.strategies[n%len(s.strategies)].Name,
+	}
+	if err := s.teamRepo.Create(ctx, tx, team); err != nil {
+		return err
+	}
+
+	if err := s.playerRepo.CreateBatch(ctx, tx, generateSquad(team.ID, s.rand.Intn)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Trade plays one round for every AI club: list surplus players, then
+// maybe buy one for the weakest position. A club whose move is refused,
+// say because the window is closed, just sits the round out.
+func (s *aiClubService) Trade(ctx context.Context) error {
+	clubs, err := s.teamRepo.GetAIClubs(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	for _, club := range clubs {
+		strategy, ok := s.strategy(club.AIStrategy)
+		if !ok {
+			log.Printf("AI club %d has unknown strategy %q", club.ID, club.AIStrategy)
+			continue
+		}
+		if err := s.trade(ctx, club, strategy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// strategy finds a club's strategy among the configured ones, falling back
+// to the presets for clubs created under an older configuration.
+func (s *aiClubService) strategy(name string) (aiclub.Strategy, bool) {
+	for _, strategy := range s.strategies {
+		if strategy.Name == name {
+			return strategy, true
+		}
+	}
+	strategy, ok := aiclub.Presets[name]
+	return strategy, ok
+}
+
+func (s *aiClubService) trade(ctx context.Context, club *models.Team, strategy aiclub.Strategy) error {
+	squad, err := s.playerRepo.GetByTeamID(ctx, s.db, club.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, listing := range strategy.Sell(s.rand, squad, s.cfg.SquadMinSize) {
+		if err := s.transfers.ListForTeam(ctx, club.ID, listing.Player.ID, listing.Price); err != nil {
+			log.Printf("AI club %d could not list player %d: %v", club.ID, listing.Player.ID, err)
+		}
+	}
+
+	position, ok := strategy.Need(s.rand, squad, s.cfg.SquadMaxSize)
+	if !ok {
+		return nil
+	}
+
+	maxPrice := club.Budget * strategy.BudgetShare
+	page, err := s.transfers.GetMarket(ctx, &models.MarketFilter{
+		Position: position,
+		MaxPrice: &maxPrice,
+		Sort:     models.MarketSortPrice,
+		Limit:    aiMarketPageSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	var market []*models.Player
+	for _, p := range page.Players {
+		if p.TeamID != club.ID {
+			market = append(market, p)
+		}
+	}
+
+	if pick := strategy.Pick(market, club.Budget); pick != nil {
+		if err := s.transfers.BuyForTeam(ctx, club.ID, pick.ID); err != nil {
+			log.Printf("AI club %d could not buy player %d: %v", club.ID, pick.ID, err)
+		}
+	}
+	return nil
+}
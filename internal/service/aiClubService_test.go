@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/aiclub"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func TestAIClubService_EnsureClubsAndTrade(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUser, sellerID := createTestTeam(t, db, "seller", 1000000)
+	var striker int
+	require.NoError(t, db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Spare', 'Striker', 'Georgia', 24, 'AT', 1000000, 0)
+		RETURNING id`, sellerID).Scan(&striker))
+
+	transfers := newTestTransferService(t, db)
+	require.NoError(t, transfers.ListPlayer(ctx, sellerUser, striker, 1200000))
+
+	// always acts, so the round does not depend on the seed
+	eager := aiclub.Strategy{
+		Name:        "eager",
+		Targets:     map[string]int{"GK": 2, "DF": 6, "MF": 6, "AT": 6},
+		ListChance:  1,
+		MaxListings: 3,
+		ListMarkup:  1.5,
+		BuyChance:   1,
+		MaxOverpay:  2,
+		BudgetShare: 0.5,
+	}
+	svc := &aiClubService{
+		db:         db,
+		teamRepo:   repository.NewTeamRepository(),
+		playerRepo: repository.NewPlayerRepository(),
+		transfers:  transfers,
+		strategies: []aiclub.Strategy{eager},
+		cfg:        &config.Config{AIClubs: 2, AIClubBudget: 5000000, SquadMinSize: 15, SquadMaxSize: 25},
+		rand:       rand.New(rand.NewSource(1)),
+	}
+
+	require.NoError(t, svc.EnsureClubs(ctx))
+	require.NoError(t, svc.EnsureClubs(ctx), "a second run creates nothing")
+
+	clubs, err := svc.teamRepo.GetAIClubs(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, clubs, 2)
+	for _, club := range clubs {
+		assert.Equal(t, "eager", club.AIStrategy)
+		assert.Zero(t, club.UserID)
+
+		var squad int
+		require.NoError(t, db.QueryRow(ctx, `SELECT COUNT(*) FROM players WHERE team_id = $1`, club.ID).Scan(&squad))
+		assert.Equal(t, 20, squad)
+	}
+
+	require.NoError(t, svc.Trade(ctx))
+
+	// each club lists its surplus goalkeeper at the markup
+	for _, club := range clubs {
+		var listed int
+		var price float64
+		require.NoError(t, db.QueryRow(ctx, `
+			SELECT COUNT(*), COALESCE(MAX(market_value), 0) FROM players
+			WHERE team_id = $1 AND on_transfer_list AND position = 'GK'`, club.ID).Scan(&listed, &price))
+		assert.Equal(t, 1, listed)
+		assert.Equal(t, 1500000.0, price)
+	}
+
+	// the first club short of attackers buys the listed one
+	var owner int
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, striker).Scan(&owner))
+	assert.Equal(t, clubs[0].ID, owner)
+
+	var budget float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, clubs[0].ID).Scan(&budget))
+	assert.Equal(t, 3800000.0, budget)
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+type AuctionService interface {
+	CreateAuction(ctx context.Context, userID int, req *models.CreateAuctionRequest) (*models.Auction, error)
+	GetOpenAuctions(ctx context.Context) ([]*models.Auction, error)
+	GetAuction(ctx context.Context, auctionID int) (*models.Auction, error)
+	PlaceBid(ctx context.Context, userID, auctionID int, amount float64) (*models.Bid, error)
+	CancelAuction(ctx context.Context, userID, auctionID int) error
+	SettleDue(ctx context.Context) error
+}
+
+type auctionService struct {
+	db          *pgxpool.Pool
+	auctionRepo *repository.AuctionRepository
+	playerRepo  *repository.PlayerRepository
+	teamRepo    *repository.TeamRepository
+	loanRepo    *repository.LoanRepository
+	executor    *TransferExecutor
+	calendar    CalendarService
+	hooks       TransferHooks
+	cfg         *config.Config
+	now         func() time.Time
+}
+
+func NewAuctionService(db *pgxpool.Pool, a *repository.AuctionRepository, p *repository.PlayerRepository, t *repository.TeamRepository, l *repository.LoanRepository, x *TransferExecutor, c CalendarService, h TransferHooks, cfg *config.Config) AuctionService {
+	return &auctionService{
+		db:          db,
+		auctionRepo: a,
+		playerRepo:  p,
+		teamRepo:    t,
+		loanRepo:    l,
+		executor:    x,
+		calendar:    c,
+		hooks:       h,
+		cfg:         cfg,
+		now:         utcNow,
+	}
+}
+
+func (s *auctionService) CreateAuction(ctx context.Context, userID int, req *models.CreateAuctionRequest) (*models.Auction, error) {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if req.EndsAt.Before(now.Add(s.cfg.AuctionMinDuration)) || req.EndsAt.After(now.Add(s.cfg.AuctionMaxDuration)) {
+		return nil, api.ErrBadRequest(locales.T(ctx, "invalid_auction_end"))
+	}
+
+	minIncrement := req.MinIncrement
+	if minIncrement == 0 {
+		minIncrement = s.cfg.AuctionMinIncrement
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, req.PlayerID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	if player.TeamID != team.ID {
+		return nil, api.ErrForbidden(locales.T(ctx, "do_not_own_player"))
+	}
+
+	if player.OnTransferList {
+		return nil, api.ErrConflict(locales.T(ctx, "player_already_listed"))
+	}
+
+	onLoan, err := s.loanRepo.HasActiveLoan(ctx, tx, player.ID)
+	if err != nil {
+		return nil, err
+	}
+	if onLoan {
+		return nil, api.ErrConflict(locales.T(ctx, "player_on_loan"))
+	}
+
+	auction := &models.Auction{
+		PlayerID:     player.ID,
+		SellerTeamID: team.ID,
+		ReservePrice: req.ReservePrice,
+		MinIncrement: minIncrement,
+		EndsAt:       req.EndsAt.UTC(),
+	}
+	if err := s.auctionRepo.Create(ctx, tx, auction); err != nil {
+		if errors.Is(err, repository.ErrAuctionExists) {
+			return nil, api.ErrConflict(locales.T(ctx, "player_in_auction"))
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	auction.Player = player
+	return auction, nil
+}
+
+func (s *auctionService) GetOpenAuctions(ctx context.Context) ([]*models.Auction, error) {
+	return s.auctionRepo.GetOpen(ctx, s.db)
+}
+
+func (s *auctionService) GetAuction(ctx context.Context, auctionID int) (*models.Auction, error) {
+	auction, err := s.auctionRepo.GetByID(ctx, s.db, auctionID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "auction_not_found"))
+	}
+
+	auction.Player, err = s.playerRepo.GetByID(ctx, s.db, auction.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	auction.Bids, err = s.auctionRepo.GetBids(ctx, s.db, auctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return auction, nil
+}
+
+// PlaceBid holds amount out of the bidder's budget and refunds the previous
+// leader, all under the auction lock so two bids cannot both lead.
+func (s *auctionService) PlaceBid(ctx context.Context, userID, auctionID int, amount float64) (*models.Bid, error) {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	auction, err := s.auctionRepo.GetByIDForUpdate(ctx, tx, auctionID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "auction_not_found"))
+	}
+
+	if auction.Status != models.AuctionOpen || !s.now().Before(auction.EndsAt) {
+		return nil, api.ErrConflict(locales.T(ctx, "auction_closed"))
+	}
+
+	if auction.SellerTeamID == team.ID {
+		return nil, api.ErrForbidden(locales.T(ctx, "own_auction_bid"))
+	}
+
+	if minimum := auction.MinimumBid(); amount < minimum {
+		return nil, api.ErrBadRequest(locales.T(ctx, "bid_too_low", minimum))
+	}
+
+	teamIDs := []int{team.ID}
+	if auction.HighBidderTeamID != nil {
+		teamIDs = append(teamIDs, *auction.HighBidderTeamID)
+	}
+	teams, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, teamIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	bidder, ok := teams[team.ID]
+	if !ok {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	available := bidder.Budget
+	if auction.HighBidderTeamID != nil {
+		if *auction.HighBidderTeamID == team.ID {
+			available += *auction.HighBid
+		}
+		if err := s.teamRepo.UpdateBudget(ctx, tx, *auction.HighBidderTeamID, *auction.HighBid); err != nil {
+			return nil, err
+		}
+	}
+
+	if available < amount {
+		return nil, api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	if err := s.teamRepo.UpdateBudget(ctx, tx, team.ID, -amount); err != nil {
+		return nil, err
+	}
+
+	bid := &models.Bid{AuctionID: auction.ID, TeamID: team.ID, Amount: amount}
+	if err := s.auctionRepo.CreateBid(ctx, tx, bid); err != nil {
+		return nil, err
+	}
+
+	if err := s.auctionRepo.SetHighBid(ctx, tx, auction.ID, team.ID, amount); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return bid, nil
+}
+
+func (s *auctionService) CancelAuction(ctx context.Context, userID, auctionID int) error {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	auction, err := s.auctionRepo.GetByIDForUpdate(ctx, tx, auctionID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "auction_not_found"))
+	}
+
+	if auction.SellerTeamID != team.ID {
+		return api.ErrForbidden(locales.T(ctx, "not_your_auction"))
+	}
+
+	if auction.Status != models.AuctionOpen {
+		return api.ErrConflict(locales.T(ctx, "auction_closed"))
+	}
+
+	if auction.HighBidderTeamID != nil {
+		return api.ErrConflict(locales.T(ctx, "auction_has_bids"))
+	}
+
+	if err := s.auctionRepo.SetStatus(ctx, tx, auction.ID, models.AuctionCancelled); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// SettleDue closes every auction whose end time has passed. Each auction is
+// settled in its own transaction so one failure does not block the rest.
+func (s *auctionService) SettleDue(ctx context.Context) error {
+	ids, err := s.auctionRepo.GetDueIDs(ctx, s.db, s.now())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, id := range ids {
+		if err := s.settle(ctx, id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *auctionService) settle(ctx context.Context, auctionID int) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	auction, err := s.auctionRepo.GetByIDForUpdate(ctx, tx, auctionID)
+	if err != nil {
+		return err
+	}
+
+	if auction.Status != models.AuctionOpen || s.now().Before(auction.EndsAt) {
+		return nil
+	}
+
+	if auction.HighBidderTeamID == nil {
+		if err := s.auctionRepo.SetStatus(ctx, tx, auction.ID, models.AuctionUnsold); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, auction.PlayerID)
+	if err != nil {
+		return err
+	}
+
+	winnerID := *auction.HighBidderTeamID
+	price := *auction.HighBid
+
+	// the seller left the game or the player moved on: give the money back
+	if auction.SellerTeamID == 0 || player.TeamID != auction.SellerTeamID {
+		if _, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, winnerID); err != nil {
+			return err
+		}
+		if err := s.teamRepo.UpdateBudget(ctx, tx, winnerID, price); err != nil {
+			return err
+		}
+		if err := s.auctionRepo.SetStatus(ctx, tx, auction.ID, models.AuctionCancelled); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	if _, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, winnerID, player.TeamID); err != nil {
+		return err
+	}
+
+	// release the hold, then pay through the same path as a market buy
+	if err := s.teamRepo.UpdateBudget(ctx, tx, winnerID, price); err != nil {
+		return err
+	}
+	if err := s.executor.Transfer(ctx, tx, player, winnerID, price, models.TransferKindAuction); err != nil {
+		return err
+	}
+	if err := s.auctionRepo.SetStatus(ctx, tx, auction.ID, models.AuctionSold); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	s.hooks.PlayerSold(ctx, player, price)
+	return nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func TestAuctionService_BidAndSettle(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUserID, sellerTeamID := createTestTeam(t, db, "seller", 1000000)
+	aliceUserID, aliceTeamID := createTestTeam(t, db, "alice", 3000000)
+	bobUserID, bobTeamID := createTestTeam(t, db, "bob", 3000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Giorgi', 'Mamardashvili', 'Georgia', 24, 'GK', 1000000, 0)
+		RETURNING id`, sellerTeamID).Scan(&playerID)
+	require.NoError(t, err)
+
+	cfg := &config.Config{
+		AuctionMinIncrement: 100000,
+		AuctionMinDuration:  time.Minute,
+		AuctionMaxDuration:  time.Hour,
+	}
+	svc := NewAuctionService(db, repository.NewAuctionRepository(), repository.NewPlayerRepository(), repository.NewTeamRepository(), repository.NewLoanRepository(), newTestExecutor(t), newTestCalendar(db), newTestWatchlist(db), cfg).(*auctionService)
+
+	start := time.Now().UTC()
+	svc.now = func() time.Time { return start }
+
+	auction, err := svc.CreateAuction(ctx, sellerUserID, &models.CreateAuctionRequest{
+		PlayerID:     playerID,
+		ReservePrice: 1500000,
+		EndsAt:       start.Add(30 * time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = svc.PlaceBid(ctx, aliceUserID, auction.ID, 1400000)
+	assert.Error(t, err, "bid below reserve")
+
+	_, err = svc.PlaceBid(ctx, aliceUserID, auction.ID, 1500000)
+	require.NoError(t, err)
+
+	_, err = svc.PlaceBid(ctx, bobUserID, auction.ID, 1550000)
+	assert.Error(t, err, "bid below minimum increment")
+
+	_, err = svc.PlaceBid(ctx, bobUserID, auction.ID, 1600000)
+	require.NoError(t, err)
+
+	budget := func(teamID int) float64 {
+		var b float64
+		require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, teamID).Scan(&b))
+		return b
+	}
+	assert.Equal(t, 3000000.0, budget(aliceTeamID), "outbid funds are released")
+	assert.Equal(t, 1400000.0, budget(bobTeamID), "leading bid is held")
+
+	_, err = svc.PlaceBid(ctx, sellerUserID, auction.ID, 2000000)
+	assert.Error(t, err, "seller cannot bid")
+
+	svc.now = func() time.Time { return start.Add(time.Hour) }
+	require.NoError(t, svc.SettleDue(ctx))
+
+	var ownerID int
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, playerID).Scan(&ownerID))
+	assert.Equal(t, bobTeamID, ownerID)
+	assert.Equal(t, 1400000.0, budget(bobTeamID))
+	assert.Equal(t, 2600000.0, budget(sellerTeamID))
+
+	settled, err := svc.GetAuction(ctx, auction.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.AuctionSold, settled.Status)
+	assert.Len(t, settled.Bids, 2)
+}
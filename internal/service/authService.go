@@ -2,44 +2,86 @@ package service
 
 import (
 	"context"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"log"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
 	"github.com/jacobpq/soccer-manager/internal/config"
 	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/keys"
 	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/mailer"
 	"github.com/jacobpq/soccer-manager/internal/repository"
+	"github.com/jacobpq/soccer-manager/internal/throttle"
+	"github.com/jacobpq/soccer-manager/internal/totp"
 )
 
 type AuthService interface {
 	Register(ctx context.Context, req models.RegisterRequest) error
-	Login(ctx context.Context, req models.LoginRequest) (*models.Session, error)
-	RefreshToken(ctx context.Context, refreshToken string) (string, error)
+	Login(ctx context.Context, req models.LoginRequest, client models.ClientInfo) (*models.LoginResult, error)
+	LoginMFA(ctx context.Context, userID int, req models.MFALoginRequest, client models.ClientInfo) (*models.Session, error)
+	RefreshToken(ctx context.Context, refreshToken string, client models.ClientInfo) (*models.Session, error)
+	Logout(ctx context.Context, userID, sessionID int) error
+	LogoutAll(ctx context.Context, userID int) error
+	ListSessions(ctx context.Context, userID, currentSessionID int) ([]*models.SessionInfo, error)
+	ChangePassword(ctx context.Context, userID, sessionID int, req models.ChangePasswordRequest) error
+	ForgotPassword(ctx context.Context, req models.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req models.ResetPasswordRequest) error
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, userID int) error
+	EnrollTOTP(ctx context.Context, userID int) (*models.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID int, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userID int, req models.DisableTOTPRequest) error
 }
 
+const (
+	totpIssuer        = "Soccer Manager"
+	recoveryCodeCount = 10
+)
+
 type authService struct {
 	db          *pgxpool.Pool
 	userRepo    *repository.UserRepository
 	teamRepo    *repository.TeamRepository
 	playerRepo  *repository.PlayerRepository
 	sessionRepo *repository.SessionRepository
-	jwtSecret   []byte
+	resetRepo   *repository.PasswordResetRepository
+	codeRepo    *repository.RecoveryCodeRepository
+	mailer      mailer.Mailer
+	keys        *keys.KeySet
+	limiter     *throttle.Limiter
+	appURL      string
+	resetTTL    time.Duration
+	verifyTTL   time.Duration
 }
 
-func NewAuthService(db *pgxpool.Pool, u *repository.UserRepository, t *repository.TeamRepository, p *repository.PlayerRepository, s *repository.SessionRepository, cfg *config.Config) AuthService {
+func NewAuthService(db *pgxpool.Pool, u *repository.UserRepository, t *repository.TeamRepository, p *repository.PlayerRepository, s *repository.SessionRepository, r *repository.PasswordResetRepository, c *repository.RecoveryCodeRepository, m mailer.Mailer, ks *keys.KeySet, l *throttle.Limiter, cfg *config.Config) AuthService {
 	return &authService{
 		db:          db,
 		userRepo:    u,
 		teamRepo:    t,
 		playerRepo:  p,
 		sessionRepo: s,
-		jwtSecret:   []byte(cfg.JWTSecret),
+		resetRepo:   r,
+		codeRepo:    c,
+		mailer:      m,
+		keys:        ks,
+		limiter:     l,
+		appURL:      cfg.AppURL,
+		resetTTL:    cfg.PasswordResetTTL,
+		verifyTTL:   cfg.EmailVerificationTTL,
 	}
 }
 
@@ -75,68 +117,547 @@ func (s *authService) Register(ctx context.Context, req models.RegisterRequest)
 		return fmt.Errorf("failed to generate players: %w", err)
 	}
 
-	return tx.Commit(ctx)
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	if err := s.sendVerification(ctx, user); err != nil {
+		log.Printf("Failed to send verification mail: %v", err)
+	}
+	return nil
 }
 
-func (s *authService) generateJWT(userID int, tokenType string, duration time.Duration) (string, error) {
-	claims := models.AuthClaims{
-		UserID:    userID,
-		TokenType: tokenType,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+func (s *authService) generateJWT(claims models.AuthClaims, duration time.Duration) (string, error) {
+	tokenID, err := newTokenID()
+	if err != nil {
+		return "", err
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.jwtSecret)
+
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ID:        tokenID,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}
+	return s.keys.Sign(claims)
+}
+
+func (s *authService) generateAccessToken(user *models.User, sessionID int) (string, error) {
+	return s.generateJWT(models.AuthClaims{
+		UserID:    user.ID,
+		TokenType: models.TokenTypeAccess,
+		SessionID: sessionID,
+		Role:      user.Role,
+	}, 30*time.Minute)
 }
 
-func (s *authService) Login(ctx context.Context, req models.LoginRequest) (*models.Session, error) {
+func (s *authService) Login(ctx context.Context, req models.LoginRequest, client models.ClientInfo) (*models.LoginResult, error) {
+	account := strings.ToLower(strings.TrimSpace(req.Email))
+	if err := s.checkThrottle(ctx, account, client.IPAddress); err != nil {
+		return nil, err
+	}
+
 	user, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
+		s.recordFailure(ctx, account, client.IPAddress)
 		return nil, api.ErrUnauthorized(locales.T(ctx, "invalid_credentials"))
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
 	if err != nil {
+		s.recordFailure(ctx, account, client.IPAddress)
 		return nil, api.ErrUnauthorized(locales.T(ctx, "invalid_credentials"))
 	}
 
-	accessToken, _ := s.generateJWT(user.ID, "access", 30*time.Minute)
+	if user.BannedAt != nil {
+		return nil, api.ErrForbidden(locales.T(ctx, "account_banned"))
+	}
+
+	if user.TOTPEnabledAt != nil {
+		// no session yet: the client trades this token and a code at /login/2fa
+		mfaToken, err := s.generateJWT(models.AuthClaims{UserID: user.ID, TokenType: models.TokenTypeMFAPending}, 5*time.Minute)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate token: %w", err)
+		}
+		return &models.LoginResult{MFAToken: mfaToken}, nil
+	}
+
+	// the counter is only cleared once the login is complete, so a known
+	// password does not let an attacker retry 2FA codes indefinitely
+	if err := s.limiter.Succeed(ctx, account); err != nil {
+		log.Printf("Failed to reset login attempts: %v", err)
+	}
+
+	session, err := s.createSession(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+	return &models.LoginResult{Session: session}, nil
+}
+
+func (s *authService) LoginMFA(ctx context.Context, userID int, req models.MFALoginRequest, client models.ClientInfo) (*models.Session, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil || user.TOTPEnabledAt == nil {
+		return nil, api.ErrUnauthorized(locales.T(ctx, "invalid_token"))
+	}
+
+	if user.BannedAt != nil {
+		return nil, api.ErrForbidden(locales.T(ctx, "account_banned"))
+	}
+
+	account := strings.ToLower(user.Email)
+	if err := s.checkThrottle(ctx, account, client.IPAddress); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifySecondFactor(ctx, user, req.Code, req.RecoveryCode); err != nil {
+		s.recordFailure(ctx, account, client.IPAddress)
+		return nil, err
+	}
 
-	refreshToken, _ := s.generateJWT(user.ID, "refresh", 7*24*time.Hour)
+	if err := s.limiter.Succeed(ctx, account); err != nil {
+		log.Printf("Failed to reset login attempts: %v", err)
+	}
+
+	return s.createSession(ctx, user, client)
+}
+
+func (s *authService) checkThrottle(ctx context.Context, account, ip string) error {
+	wait, err := s.limiter.Check(ctx, account, ip)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return api.ErrTooManyRequests(locales.T(ctx, "too_many_attempts"), wait)
+	}
+	return nil
+}
+
+func (s *authService) recordFailure(ctx context.Context, account, ip string) {
+	if _, err := s.limiter.Fail(ctx, account, ip); err != nil {
+		log.Printf("Failed to record login attempt: %v", err)
+	}
+}
+
+func (s *authService) createSession(ctx context.Context, user *models.User, client models.ClientInfo) (*models.Session, error) {
+	refreshToken, err := s.generateJWT(models.AuthClaims{UserID: user.ID, TokenType: models.TokenTypeRefresh}, 7*24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
 
 	session := &models.Session{
 		UserID:           user.ID,
-		AccessToken:      accessToken,
 		RefreshToken:     refreshToken,
 		AccessExpiresAt:  time.Now().Add(15 * time.Minute),
 		RefreshExpiresAt: time.Now().Add(7 * 24 * time.Hour),
+		UserAgent:        client.UserAgent,
+		IPAddress:        client.IPAddress,
 	}
 
 	if err := s.sessionRepo.Create(ctx, session); err != nil {
 		return nil, err
 	}
 
+	// the access token carries the session id so revoking the session kills it too
+	session.AccessToken, err = s.generateAccessToken(user, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
 	return session, nil
 }
 
-func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (string, error) {
+func (s *authService) RefreshToken(ctx context.Context, refreshToken string, client models.ClientInfo) (*models.Session, error) {
 	session, err := s.sessionRepo.GetByRefreshToken(ctx, refreshToken)
 	if err != nil {
-		return "", api.ErrUnauthorized(locales.T(ctx, "invalid_token"))
+		s.detectReuse(ctx, refreshToken)
+		return nil, api.ErrUnauthorized(locales.T(ctx, "invalid_token"))
+	}
+
+	// reload the user so role changes and bans apply from the next refresh on
+	user, err := s.userRepo.GetByID(ctx, session.UserID)
+	if err != nil || user.BannedAt != nil {
+		return nil, api.ErrUnauthorized(locales.T(ctx, "invalid_token"))
+	}
+
+	newRefreshToken, err := s.generateJWT(models.AuthClaims{UserID: user.ID, TokenType: models.TokenTypeRefresh}, time.Until(session.RefreshExpiresAt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	newAccessToken, err := s.generateJWT(session.UserID, "access", 30*time.Minute)
+	rotated, err := s.sessionRepo.Rotate(ctx, session.ID, refreshToken, newRefreshToken, client)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		// a concurrent refresh already consumed this token
+		s.detectReuse(ctx, refreshToken)
+		return nil, api.ErrUnauthorized(locales.T(ctx, "invalid_token"))
+	}
 
+	newAccessToken, err := s.generateAccessToken(user, session.ID)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate token: %w", err)
+		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	return newAccessToken, nil
+	session.RefreshToken = newRefreshToken
+	session.AccessToken = newAccessToken
+	session.AccessExpiresAt = time.Now().Add(15 * time.Minute)
+
+	return session, nil
+}
+
+// detectReuse revokes the whole session when an already rotated refresh token
+// is presented again, since either the client or an attacker holds a stale copy.
+func (s *authService) detectReuse(ctx context.Context, refreshToken string) {
+	session, err := s.sessionRepo.GetByRotatedToken(ctx, refreshToken)
+	if err != nil {
+		return
+	}
+
+	log.Printf("Refresh token reuse detected for user %d, revoking session %d", session.UserID, session.ID)
+	if _, err := s.sessionRepo.Delete(ctx, session.ID, session.UserID); err != nil {
+		log.Printf("Failed to revoke session %d: %v", session.ID, err)
+	}
+}
+
+func (s *authService) Logout(ctx context.Context, userID, sessionID int) error {
+	deleted, err := s.sessionRepo.Delete(ctx, sessionID, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return api.ErrNotFound(locales.T(ctx, "session_not_found"))
+	}
+	return nil
+}
+
+func (s *authService) LogoutAll(ctx context.Context, userID int) error {
+	return s.sessionRepo.DeleteByUserID(ctx, userID)
+}
+
+func (s *authService) ListSessions(ctx context.Context, userID, currentSessionID int) ([]*models.SessionInfo, error) {
+	sessions, err := s.sessionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		session.Current = session.ID == currentSessionID
+	}
+	return sessions, nil
+}
+
+func (s *authService) ChangePassword(ctx context.Context, userID, sessionID int, req models.ChangePasswordRequest) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "wrong_password"))
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.userRepo.UpdatePassword(ctx, tx, userID, string(hashed)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	// keep the device that changed the password logged in
+	return s.sessionRepo.DeleteOthers(ctx, userID, sessionID)
+}
+
+func (s *authService) ForgotPassword(ctx context.Context, req models.ForgotPasswordRequest) error {
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		// do not reveal whether the email is registered
+		return nil
+	}
+
+	token, err := newTokenID()
+	if err != nil {
+		return err
+	}
+
+	if err := s.resetRepo.Create(ctx, user.ID, hashToken(token), time.Now().Add(s.resetTTL)); err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/password/reset?token=%s", s.appURL, token)
+	msg := mailer.Message{
+		To:      user.Email,
+		Subject: locales.T(ctx, "password_reset_subject"),
+		Body:    locales.T(ctx, "password_reset_body", link, int(s.resetTTL.Minutes())),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		log.Printf("Failed to send password reset mail: %v", err)
+	}
+
+	return nil
+}
+
+func (s *authService) ResetPassword(ctx context.Context, req models.ResetPasswordRequest) error {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	userID, err := s.resetRepo.Consume(ctx, tx, hashToken(req.Token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return api.ErrBadRequest(locales.T(ctx, "invalid_reset_token"))
+		}
+		return err
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, tx, userID, string(hashed)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return s.sessionRepo.DeleteByUserID(ctx, userID)
+}
+
+func (s *authService) VerifyEmail(ctx context.Context, token string) error {
+	claims, err := s.parseJWT(token, models.TokenTypeVerifyEmail)
+	if err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "invalid_verification_token"))
+	}
+
+	return s.userRepo.MarkVerified(ctx, claims.UserID)
+}
+
+func (s *authService) ResendVerification(ctx context.Context, userID int) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	if user.VerifiedAt != nil {
+		return api.ErrBadRequest(locales.T(ctx, "email_already_verified"))
+	}
+
+	return s.sendVerification(ctx, user)
+}
+
+func (s *authService) sendVerification(ctx context.Context, user *models.User) error {
+	token, err := s.generateJWT(models.AuthClaims{UserID: user.ID, TokenType: models.TokenTypeVerifyEmail}, s.verifyTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify?token=%s", s.appURL, token)
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: locales.T(ctx, "verify_email_subject"),
+		Body:    locales.T(ctx, "verify_email_body", link),
+	})
+}
+
+func (s *authService) parseJWT(tokenString, tokenType string) (*models.AuthClaims, error) {
+	claims := &models.AuthClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, s.keys.Keyfunc)
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	if claims.TokenType != tokenType {
+		return nil, fmt.Errorf("unexpected token type %q", claims.TokenType)
+	}
+	return claims, nil
+}
+
+func (s *authService) EnrollTOTP(ctx context.Context, userID int) (*models.TOTPEnrollment, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	if user.TOTPEnabledAt != nil {
+		return nil, api.ErrBadRequest(locales.T(ctx, "mfa_already_enabled"))
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.SetPendingTOTPSecret(ctx, userID, secret); err != nil {
+		return nil, err
+	}
+
+	return &models.TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(totpIssuer, user.Email, secret),
+	}, nil
+}
+
+func (s *authService) ConfirmTOTP(ctx context.Context, userID int, code string) ([]string, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	if user.TOTPEnabledAt != nil {
+		return nil, api.ErrBadRequest(locales.T(ctx, "mfa_already_enabled"))
+	}
+	if user.TOTPSecret == "" {
+		return nil, api.ErrBadRequest(locales.T(ctx, "mfa_not_enrolled"))
+	}
+
+	if err := s.verifySecondFactor(ctx, user, code, ""); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.userRepo.EnableTOTP(ctx, tx, userID); err != nil {
+		return nil, err
+	}
+	if err := s.codeRepo.Replace(ctx, tx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (s *authService) DisableTOTP(ctx context.Context, userID int, req models.DisableTOTPRequest) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "user_not_found"))
+	}
+
+	if user.TOTPEnabledAt == nil {
+		return api.ErrBadRequest(locales.T(ctx, "mfa_not_enabled"))
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		return api.ErrBadRequest(locales.T(ctx, "wrong_password"))
+	}
+
+	if err := s.verifySecondFactor(ctx, user, req.Code, ""); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.userRepo.DisableTOTP(ctx, tx, userID); err != nil {
+		return err
+	}
+	if err := s.codeRepo.Replace(ctx, tx, userID, nil); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// verifySecondFactor accepts either a current TOTP code or an unused recovery
+// code. Both are single-use.
+func (s *authService) verifySecondFactor(ctx context.Context, user *models.User, code, recoveryCode string) error {
+	if recoveryCode != "" {
+		used, err := s.codeRepo.Consume(ctx, user.ID, hashToken(normalizeRecoveryCode(recoveryCode)))
+		if err != nil {
+			return err
+		}
+		if !used {
+			return api.ErrUnauthorized(locales.T(ctx, "invalid_mfa_code"))
+		}
+		return nil
+	}
+
+	step, ok := totp.Validate(user.TOTPSecret, code, time.Now())
+	if !ok {
+		return api.ErrUnauthorized(locales.T(ctx, "invalid_mfa_code"))
+	}
+
+	fresh, err := s.userRepo.UseTOTPStep(ctx, user.ID, step)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return api.ErrUnauthorized(locales.T(ctx, "invalid_mfa_code"))
+	}
+	return nil
+}
+
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := crand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := hex.EncodeToString(b)
+		codes[i] = raw[:5] + "-" + raw[5:]
+		hashes[i] = hashToken(normalizeRecoveryCode(codes[i]))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := crand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
 }
 
 func (s *authService) generateInitialSquad(teamID int) []*models.Player {
+	return generateSquad(teamID, rand.Intn)
+}
+
+// generateSquad builds the 20 players every new team starts with. intn is
+// the random source, so AI clubs can be generated reproducibly.
+func generateSquad(teamID int, intn func(n int) int) []*models.Player {
 	var players []*models.Player
 
 	positions := []string{"GK", "GK", "GK"}
@@ -151,19 +672,21 @@ func (s *authService) generateInitialSquad(teamID int) []*models.Player {
 	}
 
 	for _, pos := range positions {
-		randFirstName := repository.FirstNames[rand.Intn(len(repository.FirstNames))]
-		randLastName := repository.LastNames[rand.Intn(len(repository.LastNames))]
-		randCountry := repository.Countries[rand.Intn(len(repository.LastNames))]
-
-		players = append(players, &models.Player{
-			TeamID:    teamID,
-			FirstName: randFirstName,
-			LastName:  randLastName,
-			Country:   randCountry,
-			Age:       rand.Intn(23) + 18,
-			Position:  pos,
-			Value:     1000000,
-		})
+		players = append(players, generatePlayer(teamID, pos, intn))
 	}
 	return players
 }
+
+// generatePlayer makes up one player from the static name lists. A zero
+// teamID makes a free agent.
+func generatePlayer(teamID int, position string, intn func(n int) int) *models.Player {
+	return &models.Player{
+		TeamID:    teamID,
+		FirstName: repository.FirstNames[intn(len(repository.FirstNames))],
+		LastName:  repository.LastNames[intn(len(repository.LastNames))],
+		Country:   repository.Countries[intn(len(repository.Countries))],
+		Age:       intn(23) + 18,
+		Position:  position,
+		Value:     1000000,
+	}
+}
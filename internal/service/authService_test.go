@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/keys"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func newTestKeys(t *testing.T) *keys.KeySet {
+	t.Helper()
+	ks, err := keys.Load(&config.Config{JWTSecret: "test-secret"})
+	require.NoError(t, err)
+	return ks
+}
+
+func TestAuthService_VerifyEmail_RejectsOtherTokens(t *testing.T) {
+	svc := &authService{keys: newTestKeys(t)}
+
+	for _, tokenType := range []string{models.TokenTypeAccess, models.TokenTypeRefresh, models.TokenTypeMFAPending} {
+		t.Run(tokenType, func(t *testing.T) {
+			token, err := svc.generateJWT(models.AuthClaims{UserID: 1, TokenType: tokenType}, time.Hour)
+			require.NoError(t, err)
+
+			err = svc.VerifyEmail(context.Background(), token)
+			var appErr *api.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, http.StatusBadRequest, appErr.Status)
+		})
+	}
+}
+
+func TestAuthService_VerifyEmail(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	userID, _ := createTestTeam(t, db, "unverified", 0)
+	users := repository.NewUserRepository(db)
+	svc := &authService{keys: newTestKeys(t), userRepo: users}
+
+	verified, err := users.IsVerified(ctx, userID)
+	require.NoError(t, err)
+	assert.False(t, verified)
+
+	token, err := svc.generateJWT(models.AuthClaims{UserID: userID, TokenType: models.TokenTypeVerifyEmail}, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, svc.VerifyEmail(ctx, token))
+
+	verified, err = users.IsVerified(ctx, userID)
+	require.NoError(t, err)
+	assert.True(t, verified)
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+type CalendarService interface {
+	GetCalendar(ctx context.Context) (*models.Calendar, error)
+	RequireOpenWindow(ctx context.Context) error
+	CreateWindow(ctx context.Context, req *models.CreateWindowRequest) (*models.TransferWindow, error)
+	OpenWindow(ctx context.Context, windowID int) error
+	CloseWindow(ctx context.Context, windowID int) error
+	SeedSeasons(ctx context.Context) error
+}
+
+type calendarService struct {
+	windowRepo *repository.WindowRepository
+	cfg        *config.Config
+	now        func() time.Time
+}
+
+// utcNow is the clock of the market services. TIMESTAMP columns store the
+// wall clock pgx is given, so every time written to them is kept in UTC.
+func utcNow() time.Time {
+	return time.Now().UTC()
+}
+
+func NewCalendarService(w *repository.WindowRepository, cfg *config.Config) CalendarService {
+	return &calendarService{windowRepo: w, cfg: cfg, now: utcNow}
+}
+
+func (s *calendarService) GetCalendar(ctx context.Context) (*models.Calendar, error) {
+	now := s.now()
+
+	current, err := s.windowRepo.GetCurrent(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	upcoming, err := s.windowRepo.GetUpcoming(ctx, now, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Calendar{
+		Now:        now,
+		WindowOpen: current != nil || !s.cfg.TransferWindowsEnforced,
+		Enforced:   s.cfg.TransferWindowsEnforced,
+		Current:    current,
+		Upcoming:   upcoming,
+	}, nil
+}
+
+// RequireOpenWindow rejects transfer actions outside a window. It is a no-op
+// unless TRANSFER_WINDOWS_ENFORCED is set.
+func (s *calendarService) RequireOpenWindow(ctx context.Context) error {
+	if !s.cfg.TransferWindowsEnforced {
+		return nil
+	}
+
+	current, err := s.windowRepo.GetCurrent(ctx, s.now())
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return api.ErrForbidden(locales.T(ctx, "transfer_window_closed"))
+	}
+	return nil
+}
+
+func (s *calendarService) CreateWindow(ctx context.Context, req *models.CreateWindowRequest) (*models.TransferWindow, error) {
+	w := &models.TransferWindow{
+		Name:     strings.TrimSpace(req.Name),
+		StartsAt: req.StartsAt.UTC(),
+		EndsAt:   req.EndsAt.UTC(),
+	}
+	if err := s.windowRepo.Create(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// OpenWindow starts an upcoming window now instead of at its planned time.
+func (s *calendarService) OpenWindow(ctx context.Context, windowID int) error {
+	w, err := s.windowRepo.GetByID(ctx, windowID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "window_not_found"))
+	}
+
+	now := s.now()
+	if w.IsOpen(now) {
+		return api.ErrConflict(locales.T(ctx, "window_already_open"))
+	}
+	if !w.EndsAt.After(now) {
+		return api.ErrConflict(locales.T(ctx, "window_already_ended"))
+	}
+
+	return s.windowRepo.UpdateDates(ctx, w.ID, now, w.EndsAt)
+}
+
+// CloseWindow ends an open window now. An upcoming window is cancelled by
+// collapsing it to zero length, which keeps its season key so it is not
+// seeded again.
+func (s *calendarService) CloseWindow(ctx context.Context, windowID int) error {
+	w, err := s.windowRepo.GetByID(ctx, windowID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "window_not_found"))
+	}
+
+	now := s.now()
+	switch {
+	case w.IsOpen(now):
+		return s.windowRepo.UpdateDates(ctx, w.ID, w.StartsAt, now)
+	case w.StartsAt.After(now):
+		return s.windowRepo.UpdateDates(ctx, w.ID, w.StartsAt, w.StartsAt)
+	default:
+		return api.ErrConflict(locales.T(ctx, "window_already_ended"))
+	}
+}
+
+// SeedSeasons makes sure this year's and next year's recurring windows from
+// TRANSFER_WINDOWS exist.
+func (s *calendarService) SeedSeasons(ctx context.Context) error {
+	seasons, err := parseSeasons(s.cfg.TransferWindows)
+	if err != nil {
+		return err
+	}
+
+	year := s.now().Year()
+	for _, season := range seasons {
+		for _, y := range []int{year, year + 1} {
+			w := season.window(y)
+			if err := s.windowRepo.EnsureSeason(ctx, w); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// season is one recurring window, e.g. summer from June 1 to August 31.
+type season struct {
+	name                 string
+	startMonth, startDay int
+	endMonth, endDay     int
+}
+
+// window returns the season starting in year. The end day is inclusive; a
+// season whose end falls before its start runs into the next year.
+func (s season) window(year int) *models.TransferWindow {
+	start := time.Date(year, time.Month(s.startMonth), s.startDay, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year, time.Month(s.endMonth), s.endDay+1, 0, 0, 0, 0, time.UTC)
+	if !end.After(start) {
+		end = end.AddDate(1, 0, 0)
+	}
+
+	key := fmt.Sprintf("%s-%d", s.name, year)
+	return &models.TransferWindow{Name: s.name, SeasonKey: &key, StartsAt: start, EndsAt: end}
+}
+
+// parseSeasons reads "summer=06-01/08-31,winter=01-01/01-31".
+func parseSeasons(spec string) ([]season, error) {
+	seasons := make([]season, 0)
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var s season
+		name, dates, ok := strings.Cut(part, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid transfer window %q", part)
+		}
+		s.name = strings.TrimSpace(name)
+
+		_, err := fmt.Sscanf(dates, "%d-%d/%d-%d", &s.startMonth, &s.startDay, &s.endMonth, &s.endDay)
+		if err != nil || !validMonthDay(s.startMonth, s.startDay) || !validMonthDay(s.endMonth, s.endDay) {
+			return nil, fmt.Errorf("invalid transfer window %q", part)
+		}
+		seasons = append(seasons, s)
+	}
+	return seasons, nil
+}
+
+func validMonthDay(month, day int) bool {
+	if month < 1 || month > 12 || day < 1 {
+		return false
+	}
+	// 2024 is a leap year so Feb 29 is accepted
+	return day <= time.Date(2024, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func TestParseSeasons(t *testing.T) {
+	seasons, err := parseSeasons("summer=06-01/08-31, winter=12-15/01-31")
+	require.NoError(t, err)
+	require.Len(t, seasons, 2)
+
+	summer := seasons[0].window(2026)
+	assert.Equal(t, "summer-2026", *summer.SeasonKey)
+	assert.Equal(t, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), summer.StartsAt)
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), summer.EndsAt)
+
+	winter := seasons[1].window(2026)
+	assert.Equal(t, time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC), winter.StartsAt)
+	assert.Equal(t, time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC), winter.EndsAt)
+
+	assert.True(t, winter.IsOpen(time.Date(2027, 1, 31, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, winter.IsOpen(time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	for _, bad := range []string{"summer", "summer=13-01/08-31", "=06-01/08-31", "winter=02-30/03-01"} {
+		_, err := parseSeasons(bad)
+		assert.Error(t, err, bad)
+	}
+
+	seasons, err = parseSeasons("")
+	require.NoError(t, err)
+	assert.Empty(t, seasons)
+}
+
+func TestCalendarService_RequireOpenWindow(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	svc := NewCalendarService(repository.NewWindowRepository(db), &config.Config{TransferWindowsEnforced: true}).(*calendarService)
+
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	assert.Error(t, svc.RequireOpenWindow(ctx), "no window defined")
+
+	window, err := svc.CreateWindow(ctx, &models.CreateWindowRequest{
+		Name:     "spring",
+		StartsAt: now.Add(24 * time.Hour),
+		EndsAt:   now.Add(72 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Error(t, svc.RequireOpenWindow(ctx), "window not started yet")
+
+	require.NoError(t, svc.OpenWindow(ctx, window.ID))
+	assert.NoError(t, svc.RequireOpenWindow(ctx))
+
+	calendar, err := svc.GetCalendar(ctx)
+	require.NoError(t, err)
+	assert.True(t, calendar.WindowOpen)
+	require.NotNil(t, calendar.Current)
+	assert.Equal(t, window.ID, calendar.Current.ID)
+
+	now = now.Add(time.Hour)
+	require.NoError(t, svc.CloseWindow(ctx, window.ID))
+	assert.Error(t, svc.RequireOpenWindow(ctx))
+}
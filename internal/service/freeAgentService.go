@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+var freeAgentPositions = []string{"GK", "DF", "MF", "AT"}
+
+type FreeAgentService interface {
+	GetFreeAgents(ctx context.Context, position string, limit int) ([]*models.Player, error)
+	Sign(ctx context.Context, userID, playerID int) error
+	Release(ctx context.Context, userID, playerID int) error
+	Generate(ctx context.Context) error
+}
+
+type freeAgentService struct {
+	db           *pgxpool.Pool
+	playerRepo   *repository.PlayerRepository
+	teamRepo     *repository.TeamRepository
+	auctionRepo  *repository.AuctionRepository
+	offerRepo    *repository.OfferRepository
+	loanRepo     *repository.LoanRepository
+	transferRepo *repository.TransferRepository
+	cfg          *config.Config
+	rand         *rand.Rand
+	now          func() time.Time
+}
+
+// NewFreeAgentService builds the pool. src drives the generated players.
+func NewFreeAgentService(db *pgxpool.Pool, p *repository.PlayerRepository, t *repository.TeamRepository, a *repository.AuctionRepository, o *repository.OfferRepository, l *repository.LoanRepository, tr *repository.TransferRepository, src rand.Source, cfg *config.Config) FreeAgentService {
+	return &freeAgentService{
+		db:           db,
+		playerRepo:   p,
+		teamRepo:     t,
+		auctionRepo:  a,
+		offerRepo:    o,
+		loanRepo:     l,
+		transferRepo: tr,
+		cfg:          cfg,
+		rand:         rand.New(src),
+		now:          utcNow,
+	}
+}
+
+func (s *freeAgentService) GetFreeAgents(ctx context.Context, position string, limit int) ([]*models.Player, error) {
+	players, err := s.playerRepo.GetFreeAgents(ctx, s.db, position, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range players {
+		p.SigningFee = s.signingFee(p)
+	}
+	return players, nil
+}
+
+// Sign pays the signing fee and adds the free agent to the manager's squad.
+// Free agents can be signed outside transfer windows.
+func (s *freeAgentService) Sign(ctx context.Context, userID, playerID int) error {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, playerID)
+	if err != nil || player.TeamID != 0 {
+		return api.ErrNotFound(locales.T(ctx, "free_agent_not_found"))
+	}
+	// a teamless player can still be tied to a loan that will send him back
+	if err := s.requireUnattached(ctx, tx, playerID); err != nil {
+		return err
+	}
+
+	teams, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, team.ID)
+	if err != nil {
+		return err
+	}
+	team, ok := teams[team.ID]
+	if !ok {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	size, err := s.playerRepo.CountByTeamID(ctx, tx, team.ID)
+	if err != nil {
+		return err
+	}
+	if size >= s.cfg.SquadMaxSize {
+		return api.ErrConflict(locales.T(ctx, "squad_size_limit", s.cfg.SquadMinSize, s.cfg.SquadMaxSize))
+	}
+
+	fee := s.signingFee(player)
+	if team.Budget < fee {
+		return api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	if err := s.teamRepo.UpdateBudget(ctx, tx, team.ID, -fee); err != nil {
+		return err
+	}
+	if err := s.playerRepo.MoveToTeam(ctx, tx, player.ID, &team.ID); err != nil {
+		return err
+	}
+
+	if err := s.transferRepo.Create(ctx, tx, &models.TransferRecord{
+		PlayerID:    player.ID,
+		BuyerTeamID: &team.ID,
+		Price:       fee,
+		ValueBefore: player.Value,
+		ValueAfter:  player.Value,
+		Kind:        models.TransferKindSigning,
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Release lets the player go for free. Open offers and loan requests for
+// the player are cancelled.
+func (s *freeAgentService) Release(ctx context.Context, userID, playerID int) error {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, playerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+	if player.TeamID != team.ID {
+		return api.ErrForbidden(locales.T(ctx, "do_not_own_player"))
+	}
+
+	if err := s.requireUnattached(ctx, tx, playerID); err != nil {
+		return err
+	}
+
+	if _, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, team.ID); err != nil {
+		return err
+	}
+	size, err := s.playerRepo.CountByTeamID(ctx, tx, team.ID)
+	if err != nil {
+		return err
+	}
+	if size <= s.cfg.SquadMinSize {
+		return api.ErrConflict(locales.T(ctx, "squad_size_limit", s.cfg.SquadMinSize, s.cfg.SquadMaxSize))
+	}
+
+	if err := s.playerRepo.Release(ctx, tx, playerID); err != nil {
+		return err
+	}
+
+	now := s.now()
+	if err := s.offerRepo.CancelPendingForPlayer(ctx, tx, playerID, now); err != nil {
+		return err
+	}
+	if err := s.loanRepo.CancelPendingForPlayer(ctx, tx, playerID, now); err != nil {
+		return err
+	}
+
+	if err := s.transferRepo.Create(ctx, tx, &models.TransferRecord{
+		PlayerID:     player.ID,
+		SellerTeamID: &team.ID,
+		ValueBefore:  player.Value,
+		ValueAfter:   player.Value,
+		Kind:         models.TransferKindRelease,
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// Generate adds up to FREE_AGENT_BATCH new players to the pool, never
+// growing it past FREE_AGENT_POOL_SIZE.
+func (s *freeAgentService) Generate(ctx context.Context) error {
+	count, err := s.playerRepo.CountFreeAgents(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	n := min(s.cfg.FreeAgentBatch, s.cfg.FreeAgentPoolSize-count)
+	if n <= 0 {
+		return nil
+	}
+
+	players := make([]*models.Player, 0, n)
+	for i := 0; i < n; i++ {
+		position := freeAgentPositions[s.rand.Intn(len(freeAgentPositions))]
+		players = append(players, generatePlayer(0, position, s.rand.Intn))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.playerRepo.CreateBatch(ctx, tx, players); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (s *freeAgentService) signingFee(player *models.Player) float64 {
+	return math.Round(player.Value * s.cfg.FreeAgentSigningFee)
+}
+
+// requireUnattached refuses players on an active loan or in an open
+// auction. Callers pass the tx holding the player's row lock.
+func (s *freeAgentService) requireUnattached(ctx context.Context, q repository.Querier, playerID int) error {
+	onLoan, err := s.loanRepo.HasActiveLoan(ctx, q, playerID)
+	if err != nil {
+		return err
+	}
+	if onLoan {
+		return api.ErrConflict(locales.T(ctx, "player_on_loan"))
+	}
+
+	inAuction, err := s.auctionRepo.HasOpenAuction(ctx, q, playerID)
+	if err != nil {
+		return err
+	}
+	if inAuction {
+		return api.ErrConflict(locales.T(ctx, "player_in_auction"))
+	}
+	return nil
+}
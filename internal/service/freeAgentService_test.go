@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func newTestFreeAgentService(db *pgxpool.Pool, cfg *config.Config) FreeAgentService {
+	return NewFreeAgentService(db, repository.NewPlayerRepository(), repository.NewTeamRepository(), repository.NewAuctionRepository(),
+		repository.NewOfferRepository(), repository.NewLoanRepository(), repository.NewTransferRepository(), rand.NewSource(1), cfg)
+}
+
+func TestFreeAgentService_ReleaseAndSign(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	releasingUser, releasingID := createTestTeam(t, db, "releasing", 1000000)
+	signingUser, signingID := createTestTeam(t, db, "signing", 1000000)
+	squad := createTestSquad(t, db, releasingID, 3)
+	createTestSquad(t, db, signingID, 2)
+
+	svc := newTestFreeAgentService(db, &config.Config{SquadMinSize: 2, SquadMaxSize: 3, FreeAgentSigningFee: 0.2})
+
+	require.NoError(t, svc.Release(ctx, releasingUser, squad[0]))
+	assert.Error(t, svc.Release(ctx, releasingUser, squad[1]), "squad would drop below the minimum")
+	assert.Error(t, svc.Release(ctx, signingUser, squad[1]), "only the owner releases")
+
+	agents, err := svc.GetFreeAgents(ctx, "", 10)
+	require.NoError(t, err)
+	require.Len(t, agents, 1)
+	assert.Equal(t, squad[0], agents[0].ID)
+	assert.Equal(t, 200000.0, agents[0].SigningFee)
+
+	require.NoError(t, svc.Sign(ctx, signingUser, squad[0]))
+	assert.Error(t, svc.Sign(ctx, releasingUser, squad[0]), "no longer a free agent")
+
+	var owner int
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, squad[0]).Scan(&owner))
+	assert.Equal(t, signingID, owner)
+
+	var budget float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, signingID).Scan(&budget))
+	assert.Equal(t, 800000.0, budget)
+
+	history, err := repository.NewTransferRepository().GetByPlayerID(ctx, db, squad[0])
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, models.TransferKindSigning, history[0].Kind)
+	assert.Equal(t, models.TransferKindRelease, history[1].Kind)
+}
+
+func TestFreeAgentService_SignRefusesLoanedPlayer(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	_, lenderID := createTestTeam(t, db, "lender", 1000000)
+	signingUser, _ := createTestTeam(t, db, "signing", 1000000)
+	player := createTestSquad(t, db, lenderID, 1)[0]
+
+	// the borrower is gone but the loan still sends him back to the lender
+	_, err := db.Exec(ctx, `
+		INSERT INTO loans (player_id, lender_team_id, fee, days, status, expires_at, starts_at, ends_at)
+		VALUES ($1, $2, 0, 7, 'active', NOW(), NOW(), NOW() + INTERVAL '7 days')`, player, lenderID)
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, `UPDATE players SET team_id = NULL WHERE id = $1`, player)
+	require.NoError(t, err)
+
+	svc := newTestFreeAgentService(db, &config.Config{SquadMinSize: 0, SquadMaxSize: 25, FreeAgentSigningFee: 0.2})
+	assert.Error(t, svc.Sign(ctx, signingUser, player))
+}
+
+func TestFreeAgentService_Generate(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	svc := newTestFreeAgentService(db, &config.Config{FreeAgentBatch: 3, FreeAgentPoolSize: 5})
+
+	require.NoError(t, svc.Generate(ctx))
+	require.NoError(t, svc.Generate(ctx))
+	require.NoError(t, svc.Generate(ctx))
+
+	count, err := repository.NewPlayerRepository().CountFreeAgents(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, 5, count, "the pool stops growing at its size")
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+type OfferService interface {
+	CreateOffer(ctx context.Context, userID, playerID int, amount float64) (*models.TransferOffer, error)
+	GetIncoming(ctx context.Context, userID int) ([]*models.TransferOffer, error)
+	GetOutgoing(ctx context.Context, userID int) ([]*models.TransferOffer, error)
+	AcceptOffer(ctx context.Context, userID, offerID int) error
+	RejectOffer(ctx context.Context, userID, offerID int) error
+	CounterOffer(ctx context.Context, userID, offerID int, amount float64) (*models.TransferOffer, error)
+	ExpireOffers(ctx context.Context) error
+}
+
+type offerService struct {
+	db          *pgxpool.Pool
+	offerRepo   *repository.OfferRepository
+	playerRepo  *repository.PlayerRepository
+	teamRepo    *repository.TeamRepository
+	auctionRepo *repository.AuctionRepository
+	loanRepo    *repository.LoanRepository
+	executor    *TransferExecutor
+	calendar    CalendarService
+	hooks       TransferHooks
+	cfg         *config.Config
+	now         func() time.Time
+}
+
+func NewOfferService(db *pgxpool.Pool, o *repository.OfferRepository, p *repository.PlayerRepository, t *repository.TeamRepository, a *repository.AuctionRepository, l *repository.LoanRepository, x *TransferExecutor, c CalendarService, h TransferHooks, cfg *config.Config) OfferService {
+	return &offerService{
+		db:          db,
+		offerRepo:   o,
+		playerRepo:  p,
+		teamRepo:    t,
+		auctionRepo: a,
+		loanRepo:    l,
+		executor:    x,
+		calendar:    c,
+		hooks:       h,
+		cfg:         cfg,
+		now:         utcNow,
+	}
+}
+
+func (s *offerService) CreateOffer(ctx context.Context, userID, playerID int, amount float64) (*models.TransferOffer, error) {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	player, err := s.playerRepo.GetByID(ctx, s.db, playerID)
+	if err != nil || player.TeamID == 0 {
+		return nil, api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	if player.TeamID == team.ID {
+		return nil, api.ErrBadRequest(locales.T(ctx, "own_player_offer"))
+	}
+
+	if err := s.requireNotOnLoan(ctx, s.db, player.ID); err != nil {
+		return nil, err
+	}
+
+	if team.Budget < amount {
+		return nil, api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	offer := &models.TransferOffer{
+		PlayerID:         player.ID,
+		BuyerTeamID:      team.ID,
+		SellerTeamID:     player.TeamID,
+		ProposedByTeamID: team.ID,
+		Amount:           amount,
+		ExpiresAt:        s.now().Add(s.cfg.OfferTTL),
+	}
+	if err := s.offerRepo.Create(ctx, tx, offer); err != nil {
+		if errors.Is(err, repository.ErrOfferExists) {
+			return nil, api.ErrConflict(locales.T(ctx, "offer_exists"))
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	offer.Player = player
+	return offer, nil
+}
+
+func (s *offerService) GetIncoming(ctx context.Context, userID int) ([]*models.TransferOffer, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+	return s.offerRepo.GetIncoming(ctx, s.db, team.ID, s.now())
+}
+
+func (s *offerService) GetOutgoing(ctx context.Context, userID int) ([]*models.TransferOffer, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+	return s.offerRepo.GetOutgoing(ctx, s.db, team.ID, s.now())
+}
+
+// AcceptOffer completes the transfer at the offered amount. Locks are taken
+// offer first, then player, then both teams.
+func (s *offerService) AcceptOffer(ctx context.Context, userID, offerID int) error {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return err
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	offer, err := s.pendingOffer(ctx, tx, team.ID, offerID)
+	if err != nil {
+		return err
+	}
+
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, offer.PlayerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	if player.TeamID != offer.SellerTeamID {
+		return api.ErrConflict(locales.T(ctx, "offer_player_moved"))
+	}
+
+	inAuction, err := s.auctionRepo.HasOpenAuction(ctx, tx, player.ID)
+	if err != nil {
+		return err
+	}
+	if inAuction {
+		return api.ErrConflict(locales.T(ctx, "player_in_auction"))
+	}
+
+	if err := s.requireNotOnLoan(ctx, tx, player.ID); err != nil {
+		return err
+	}
+
+	teams, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, offer.BuyerTeamID, offer.SellerTeamID)
+	if err != nil {
+		return err
+	}
+
+	buyer, ok := teams[offer.BuyerTeamID]
+	if !ok {
+		return api.ErrNotFound(locales.T(ctx, "buyer_team_not_found"))
+	}
+
+	if buyer.Budget < offer.Amount {
+		return api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	if err := s.executor.Transfer(ctx, tx, player, offer.BuyerTeamID, offer.Amount, models.TransferKindOffer); err != nil {
+		return err
+	}
+
+	now := s.now()
+	if err := s.offerRepo.CancelPendingForPlayer(ctx, tx, player.ID, now); err != nil {
+		return err
+	}
+	if err := s.offerRepo.SetStatus(ctx, tx, offer.ID, models.OfferAccepted, now); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	s.hooks.PlayerSold(ctx, player, offer.Amount)
+	return nil
+}
+
+// RejectOffer closes a pending offer. The side it is waiting on rejects it;
+// the side that made it withdraws it.
+func (s *offerService) RejectOffer(ctx context.Context, userID, offerID int) error {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	offer, err := s.offerRepo.GetByIDForUpdate(ctx, tx, offerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "offer_not_found"))
+	}
+
+	status := models.OfferRejected
+	switch team.ID {
+	case offer.RecipientTeamID():
+	case offer.ProposedByTeamID:
+		status = models.OfferCancelled
+	default:
+		return api.ErrNotFound(locales.T(ctx, "offer_not_found"))
+	}
+
+	if err := s.requireOpen(ctx, offer); err != nil {
+		return err
+	}
+
+	if err := s.offerRepo.SetStatus(ctx, tx, offer.ID, status, s.now()); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// CounterOffer closes the offer and proposes a new amount back to the other side.
+func (s *offerService) CounterOffer(ctx context.Context, userID, offerID int, amount float64) (*models.TransferOffer, error) {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	offer, err := s.pendingOffer(ctx, tx, team.ID, offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if err := s.offerRepo.SetStatus(ctx, tx, offer.ID, models.OfferCountered, now); err != nil {
+		return nil, err
+	}
+
+	counter := &models.TransferOffer{
+		PlayerID:         offer.PlayerID,
+		BuyerTeamID:      offer.BuyerTeamID,
+		SellerTeamID:     offer.SellerTeamID,
+		ProposedByTeamID: team.ID,
+		ParentOfferID:    &offer.ID,
+		Amount:           amount,
+		ExpiresAt:        now.Add(s.cfg.OfferTTL),
+	}
+	if err := s.offerRepo.Create(ctx, tx, counter); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return counter, nil
+}
+
+func (s *offerService) ExpireOffers(ctx context.Context) error {
+	_, err := s.offerRepo.ExpirePending(ctx, s.db, s.now())
+	return err
+}
+
+func (s *offerService) requireNotOnLoan(ctx context.Context, q repository.Querier, playerID int) error {
+	onLoan, err := s.loanRepo.HasActiveLoan(ctx, q, playerID)
+	if err != nil {
+		return err
+	}
+	if onLoan {
+		return api.ErrConflict(locales.T(ctx, "player_on_loan"))
+	}
+	return nil
+}
+
+// pendingOffer locks the offer and checks that teamID is the side expected to answer it.
+func (s *offerService) pendingOffer(ctx context.Context, tx pgx.Tx, teamID, offerID int) (*models.TransferOffer, error) {
+	offer, err := s.offerRepo.GetByIDForUpdate(ctx, tx, offerID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "offer_not_found"))
+	}
+
+	if offer.RecipientTeamID() != teamID {
+		if offer.BuyerTeamID == teamID || offer.SellerTeamID == teamID {
+			return nil, api.ErrForbidden(locales.T(ctx, "offer_awaiting_other_side"))
+		}
+		return nil, api.ErrNotFound(locales.T(ctx, "offer_not_found"))
+	}
+
+	if err := s.requireOpen(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// requireOpen rejects offers that were already answered or have expired.
+func (s *offerService) requireOpen(ctx context.Context, offer *models.TransferOffer) error {
+	if offer.Status != models.OfferPending {
+		return api.ErrConflict(locales.T(ctx, "offer_closed"))
+	}
+
+	if !s.now().Before(offer.ExpiresAt) {
+		return api.ErrConflict(locales.T(ctx, "offer_expired"))
+	}
+	return nil
+}
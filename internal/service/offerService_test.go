@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func TestOfferService_CounterAndAccept(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUserID, sellerTeamID := createTestTeam(t, db, "seller", 1000000)
+	buyerUserID, buyerTeamID := createTestTeam(t, db, "buyer", 5000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Zuriko', 'Davitashvili', 'Georgia', 23, 'AT', 1000000, 0)
+		RETURNING id`, sellerTeamID).Scan(&playerID)
+	require.NoError(t, err)
+
+	cfg := &config.Config{OfferTTL: time.Hour}
+	svc := NewOfferService(db, repository.NewOfferRepository(), repository.NewPlayerRepository(),
+		repository.NewTeamRepository(), repository.NewAuctionRepository(), repository.NewLoanRepository(), newTestExecutor(t), newTestCalendar(db), newTestWatchlist(db), cfg).(*offerService)
+
+	offer, err := svc.CreateOffer(ctx, buyerUserID, playerID, 1500000)
+	require.NoError(t, err)
+
+	assert.Error(t, svc.AcceptOffer(ctx, buyerUserID, offer.ID), "buyer cannot accept own offer")
+
+	counter, err := svc.CounterOffer(ctx, sellerUserID, offer.ID, 2000000)
+	require.NoError(t, err)
+
+	incoming, err := svc.GetIncoming(ctx, buyerUserID)
+	require.NoError(t, err)
+	require.Len(t, incoming, 1)
+	assert.Equal(t, counter.ID, incoming[0].ID)
+
+	assert.Error(t, svc.AcceptOffer(ctx, sellerUserID, offer.ID), "countered offer is closed")
+	require.NoError(t, svc.AcceptOffer(ctx, buyerUserID, counter.ID))
+
+	var ownerID int
+	var sellerBudget, buyerBudget float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, playerID).Scan(&ownerID))
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, sellerTeamID).Scan(&sellerBudget))
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, buyerTeamID).Scan(&buyerBudget))
+	assert.Equal(t, buyerTeamID, ownerID)
+	assert.Equal(t, 3000000.0, sellerBudget)
+	assert.Equal(t, 3000000.0, buyerBudget)
+}
+
+func TestOfferService_Withdraw(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUserID, sellerTeamID := createTestTeam(t, db, "seller", 1000000)
+	buyerUserID, _ := createTestTeam(t, db, "buyer", 5000000)
+	otherUserID, _ := createTestTeam(t, db, "other", 5000000)
+	player := createTestSquad(t, db, sellerTeamID, 1)[0]
+
+	svc := NewOfferService(db, repository.NewOfferRepository(), repository.NewPlayerRepository(),
+		repository.NewTeamRepository(), repository.NewAuctionRepository(), repository.NewLoanRepository(), newTestExecutor(t), newTestCalendar(db), newTestWatchlist(db),
+		&config.Config{OfferTTL: time.Hour})
+
+	offer, err := svc.CreateOffer(ctx, buyerUserID, player, 1500000)
+	require.NoError(t, err)
+
+	assert.Error(t, svc.RejectOffer(ctx, otherUserID, offer.ID), "only the two sides can close it")
+	require.NoError(t, svc.RejectOffer(ctx, buyerUserID, offer.ID))
+	assert.Error(t, svc.AcceptOffer(ctx, sellerUserID, offer.ID), "a withdrawn offer is closed")
+
+	var status string
+	require.NoError(t, db.QueryRow(ctx, `SELECT status FROM transfer_offers WHERE id = $1`, offer.ID).Scan(&status))
+	assert.Equal(t, models.OfferCancelled, status)
+}
+
+func TestOfferService_Expired(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUserID, sellerTeamID := createTestTeam(t, db, "seller", 1000000)
+	buyerUserID, _ := createTestTeam(t, db, "buyer", 5000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Otar', 'Kiteishvili', 'Georgia', 28, 'MD', 1000000, 0)
+		RETURNING id`, sellerTeamID).Scan(&playerID)
+	require.NoError(t, err)
+
+	cfg := &config.Config{OfferTTL: time.Hour}
+	svc := NewOfferService(db, repository.NewOfferRepository(), repository.NewPlayerRepository(),
+		repository.NewTeamRepository(), repository.NewAuctionRepository(), repository.NewLoanRepository(), newTestExecutor(t), newTestCalendar(db), newTestWatchlist(db), cfg).(*offerService)
+
+	start := time.Now().UTC()
+	svc.now = func() time.Time { return start }
+
+	offer, err := svc.CreateOffer(ctx, buyerUserID, playerID, 1500000)
+	require.NoError(t, err)
+
+	svc.now = func() time.Time { return start.Add(2 * time.Hour) }
+	assert.Error(t, svc.AcceptOffer(ctx, sellerUserID, offer.ID))
+
+	require.NoError(t, svc.ExpireOffers(ctx))
+	incoming, err := svc.GetIncoming(ctx, sellerUserID)
+	require.NoError(t, err)
+	assert.Empty(t, incoming)
+}
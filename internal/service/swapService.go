@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+type SwapService interface {
+	CreateSwap(ctx context.Context, userID int, req *models.CreateSwapRequest) (*models.Swap, error)
+	GetSwaps(ctx context.Context, userID int) ([]*models.Swap, error)
+	AcceptSwap(ctx context.Context, userID, swapID int) error
+	RejectSwap(ctx context.Context, userID, swapID int) error
+	ExpireSwaps(ctx context.Context) error
+}
+
+type swapService struct {
+	db           *pgxpool.Pool
+	swapRepo     *repository.SwapRepository
+	playerRepo   *repository.PlayerRepository
+	teamRepo     *repository.TeamRepository
+	auctionRepo  *repository.AuctionRepository
+	offerRepo    *repository.OfferRepository
+	loanRepo     *repository.LoanRepository
+	transferRepo *repository.TransferRepository
+	calendar     CalendarService
+	hooks        TransferHooks
+	cfg          *config.Config
+	now          func() time.Time
+}
+
+func NewSwapService(db *pgxpool.Pool, s *repository.SwapRepository, p *repository.PlayerRepository, t *repository.TeamRepository, a *repository.AuctionRepository, o *repository.OfferRepository, l *repository.LoanRepository, tr *repository.TransferRepository, c CalendarService, h TransferHooks, cfg *config.Config) SwapService {
+	return &swapService{
+		db:           db,
+		swapRepo:     s,
+		playerRepo:   p,
+		teamRepo:     t,
+		auctionRepo:  a,
+		offerRepo:    o,
+		loanRepo:     l,
+		transferRepo: tr,
+		calendar:     c,
+		hooks:        h,
+		cfg:          cfg,
+		now:          utcNow,
+	}
+}
+
+// CreateSwap proposes the trade to the team owning the requested players.
+// Everything is checked again when the other side accepts.
+func (s *swapService) CreateSwap(ctx context.Context, userID int, req *models.CreateSwapRequest) (*models.Swap, error) {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	swap := &models.Swap{
+		ProposerTeamID: team.ID,
+		Cash:           req.Cash,
+		ExpiresAt:      s.now().Add(s.cfg.SwapTTL),
+	}
+
+	for _, id := range req.OfferedPlayerIDs {
+		player, err := s.tradablePlayer(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if player.TeamID != team.ID {
+			return nil, api.ErrForbidden(locales.T(ctx, "do_not_own_player"))
+		}
+		swap.Players = append(swap.Players, &models.SwapPlayer{PlayerID: id, FromTeamID: team.ID, Player: player})
+	}
+
+	for _, id := range req.RequestedPlayerIDs {
+		player, err := s.tradablePlayer(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if player.TeamID == team.ID {
+			return nil, api.ErrBadRequest(locales.T(ctx, "own_player_swap"))
+		}
+		if swap.RecipientTeamID == 0 {
+			swap.RecipientTeamID = player.TeamID
+		}
+		if player.TeamID != swap.RecipientTeamID {
+			return nil, api.ErrBadRequest(locales.T(ctx, "swap_one_team"))
+		}
+		swap.Players = append(swap.Players, &models.SwapPlayer{PlayerID: id, FromTeamID: player.TeamID, Player: player})
+	}
+
+	if req.Cash > 0 && team.Budget < req.Cash {
+		return nil, api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.swapRepo.Create(ctx, tx, swap); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return swap, nil
+}
+
+func (s *swapService) GetSwaps(ctx context.Context, userID int) ([]*models.Swap, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+	return s.swapRepo.GetPendingByTeamID(ctx, s.db, team.ID, s.now())
+}
+
+// AcceptSwap moves every player and the cash in one transaction. Locks are
+// taken swap first, then players by id, then both teams.
+func (s *swapService) AcceptSwap(ctx context.Context, userID, swapID int) error {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return err
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	swap, err := s.pendingSwap(ctx, tx, swapID)
+	if err != nil {
+		return err
+	}
+
+	if swap.RecipientTeamID != team.ID {
+		if swap.ProposerTeamID == team.ID {
+			return api.ErrForbidden(locales.T(ctx, "swap_awaiting_other_side"))
+		}
+		return api.ErrNotFound(locales.T(ctx, "swap_not_found"))
+	}
+
+	players := make(map[int]*models.Player, len(swap.Players))
+	for _, sp := range swap.Players {
+		player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, sp.PlayerID)
+		if err != nil || player.TeamID != sp.FromTeamID {
+			return api.ErrConflict(locales.T(ctx, "swap_player_moved"))
+		}
+		if err := s.requireTradable(ctx, tx, sp.PlayerID); err != nil {
+			return err
+		}
+		players[sp.PlayerID] = player
+	}
+
+	teams, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, swap.ProposerTeamID, swap.RecipientTeamID)
+	if err != nil {
+		return err
+	}
+	if len(teams) != 2 {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	proposerOut, recipientOut := len(swap.PlayersFrom(swap.ProposerTeamID)), len(swap.PlayersFrom(swap.RecipientTeamID))
+	if err := s.checkSquadSize(ctx, tx, swap.ProposerTeamID, recipientOut-proposerOut); err != nil {
+		return err
+	}
+	if err := s.checkSquadSize(ctx, tx, swap.RecipientTeamID, proposerOut-recipientOut); err != nil {
+		return err
+	}
+
+	payer, payee := swap.ProposerTeamID, swap.RecipientTeamID
+	if swap.Cash < 0 {
+		payer, payee = payee, payer
+	}
+	amount := math.Abs(swap.Cash)
+
+	if amount != 0 {
+		if teams[payer].Budget < amount {
+			return api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+		}
+		if err := s.teamRepo.UpdateBudget(ctx, tx, payer, -amount); err != nil {
+			return err
+		}
+		if err := s.teamRepo.UpdateBudget(ctx, tx, payee, amount); err != nil {
+			return err
+		}
+	}
+
+	now := s.now()
+	sold := make([]*models.TransferRecord, 0, len(swap.Players))
+	for _, sp := range swap.Players {
+		player := players[sp.PlayerID]
+		toTeamID := swap.RecipientTeamID
+		if sp.FromTeamID == swap.RecipientTeamID {
+			toTeamID = swap.ProposerTeamID
+		}
+
+		if err := s.playerRepo.TransferOwnership(ctx, tx, player.ID, toTeamID, player.Value, player.BaseValue); err != nil {
+			return err
+		}
+
+		fromTeamID := sp.FromTeamID
+		record := &models.TransferRecord{
+			PlayerID:     player.ID,
+			SellerTeamID: &fromTeamID,
+			BuyerTeamID:  &toTeamID,
+			ValueBefore:  player.Value,
+			ValueAfter:   player.Value,
+			Kind:         models.TransferKindSwap,
+		}
+		// the cash shows on the first player the receiving side gives up
+		if fromTeamID == payee {
+			record.Price, amount = amount, 0
+		}
+		if err := s.transferRepo.Create(ctx, tx, record); err != nil {
+			return err
+		}
+		sold = append(sold, record)
+
+		if err := s.offerRepo.CancelPendingForPlayer(ctx, tx, player.ID, now); err != nil {
+			return err
+		}
+		if err := s.loanRepo.CancelPendingForPlayer(ctx, tx, player.ID, now); err != nil {
+			return err
+		}
+	}
+
+	if err := s.swapRepo.SetStatus(ctx, tx, swap.ID, models.SwapAccepted, now); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	for _, record := range sold {
+		s.hooks.PlayerSold(ctx, players[record.PlayerID], record.Price)
+	}
+	return nil
+}
+
+// RejectSwap closes a pending swap. The recipient rejects it; the proposer
+// withdraws it.
+func (s *swapService) RejectSwap(ctx context.Context, userID, swapID int) error {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	swap, err := s.pendingSwap(ctx, tx, swapID)
+	if err != nil {
+		return err
+	}
+
+	status := models.SwapRejected
+	switch team.ID {
+	case swap.RecipientTeamID:
+	case swap.ProposerTeamID:
+		status = models.SwapCancelled
+	default:
+		return api.ErrNotFound(locales.T(ctx, "swap_not_found"))
+	}
+
+	if err := s.swapRepo.SetStatus(ctx, tx, swap.ID, status, s.now()); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *swapService) ExpireSwaps(ctx context.Context) error {
+	_, err := s.swapRepo.ExpirePending(ctx, s.db, s.now())
+	return err
+}
+
+// tradablePlayer reads a player with a team that can change hands right now.
+func (s *swapService) tradablePlayer(ctx context.Context, playerID int) (*models.Player, error) {
+	player, err := s.playerRepo.GetByID(ctx, s.db, playerID)
+	if err != nil || player.TeamID == 0 {
+		return nil, api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+	if err := s.requireTradable(ctx, s.db, playerID); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// requireTradable rejects players that are loaned out or up for auction.
+// Pass the open tx when the player row is locked.
+func (s *swapService) requireTradable(ctx context.Context, q repository.Querier, playerID int) error {
+	onLoan, err := s.loanRepo.HasActiveLoan(ctx, q, playerID)
+	if err != nil {
+		return err
+	}
+	if onLoan {
+		return api.ErrConflict(locales.T(ctx, "player_on_loan"))
+	}
+
+	inAuction, err := s.auctionRepo.HasOpenAuction(ctx, q, playerID)
+	if err != nil {
+		return err
+	}
+	if inAuction {
+		return api.ErrConflict(locales.T(ctx, "player_in_auction"))
+	}
+	return nil
+}
+
+// checkSquadSize rejects a swap that takes a squad outside SQUAD_MIN_SIZE and
+// SQUAD_MAX_SIZE. A squad already outside the limits may still trade as long
+// as it does not move further away from them.
+func (s *swapService) checkSquadSize(ctx context.Context, tx pgx.Tx, teamID, change int) error {
+	size, err := s.playerRepo.CountByTeamID(ctx, tx, teamID)
+	if err != nil {
+		return err
+	}
+
+	after := size + change
+	if (change < 0 && after < s.cfg.SquadMinSize) || (change > 0 && after > s.cfg.SquadMaxSize) {
+		return api.ErrConflict(locales.T(ctx, "squad_size_limit", s.cfg.SquadMinSize, s.cfg.SquadMaxSize))
+	}
+	return nil
+}
+
+// pendingSwap locks the swap and checks it can still be answered.
+func (s *swapService) pendingSwap(ctx context.Context, tx pgx.Tx, swapID int) (*models.Swap, error) {
+	swap, err := s.swapRepo.GetByIDForUpdate(ctx, tx, swapID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "swap_not_found"))
+	}
+
+	if swap.Status != models.SwapPending {
+		return nil, api.ErrConflict(locales.T(ctx, "swap_closed"))
+	}
+
+	if !s.now().Before(swap.ExpiresAt) {
+		return nil, api.ErrConflict(locales.T(ctx, "swap_expired"))
+	}
+
+	return swap, nil
+}
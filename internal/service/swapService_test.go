@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func newTestSwapService(db *pgxpool.Pool, cfg *config.Config) *swapService {
+	return NewSwapService(db, repository.NewSwapRepository(), repository.NewPlayerRepository(), repository.NewTeamRepository(),
+		repository.NewAuctionRepository(), repository.NewOfferRepository(), repository.NewLoanRepository(),
+		repository.NewTransferRepository(), newTestCalendar(db), newTestWatchlist(db), cfg).(*swapService)
+}
+
+// createTestSquad inserts n midfielders for the team and returns their ids.
+func createTestSquad(t *testing.T, db *pgxpool.Pool, teamID, n int) []int {
+	t.Helper()
+
+	ids := make([]int, n)
+	for i := range ids {
+		err := db.QueryRow(context.Background(), `
+			INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+			VALUES ($1, 'Squad', 'Player', 'Georgia', 24, 'MF', 1000000, 0)
+			RETURNING id`, teamID).Scan(&ids[i])
+		require.NoError(t, err)
+	}
+	return ids
+}
+
+func TestSwapService_AcceptWithCash(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	proposerUser, proposerID := createTestTeam(t, db, "proposer", 1000000)
+	recipientUser, recipientID := createTestTeam(t, db, "recipient", 1000000)
+	mine := createTestSquad(t, db, proposerID, 3)
+	theirs := createTestSquad(t, db, recipientID, 3)
+
+	svc := newTestSwapService(db, &config.Config{SquadMinSize: 2, SquadMaxSize: 4, SwapTTL: time.Hour})
+
+	swap, err := svc.CreateSwap(ctx, proposerUser, &models.CreateSwapRequest{
+		OfferedPlayerIDs:   []int{mine[0]},
+		RequestedPlayerIDs: []int{theirs[0]},
+		Cash:               500000,
+	})
+	require.NoError(t, err)
+
+	assert.Error(t, svc.AcceptSwap(ctx, proposerUser, swap.ID), "proposer cannot accept")
+	require.NoError(t, svc.AcceptSwap(ctx, recipientUser, swap.ID))
+
+	var owner int
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, mine[0]).Scan(&owner))
+	assert.Equal(t, recipientID, owner)
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, theirs[0]).Scan(&owner))
+	assert.Equal(t, proposerID, owner)
+
+	var proposerBudget, recipientBudget float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, proposerID).Scan(&proposerBudget))
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, recipientID).Scan(&recipientBudget))
+	assert.Equal(t, 500000.0, proposerBudget)
+	assert.Equal(t, 1500000.0, recipientBudget)
+
+	var ledger int
+	require.NoError(t, db.QueryRow(ctx, `SELECT COUNT(*) FROM transfers WHERE kind = 'swap'`).Scan(&ledger))
+	assert.Equal(t, 2, ledger)
+
+	var price float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT price FROM transfers WHERE kind = 'swap' AND player_id = $1`, theirs[0]).Scan(&price))
+	assert.Equal(t, 500000.0, price, "the cash is recorded against the side that received it")
+	require.NoError(t, db.QueryRow(ctx, `SELECT price FROM transfers WHERE kind = 'swap' AND player_id = $1`, mine[0]).Scan(&price))
+	assert.Equal(t, 0.0, price)
+}
+
+func TestSwapService_SquadSizeCheckedOnAccept(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	proposerUser, proposerID := createTestTeam(t, db, "proposer", 1000000)
+	recipientUser, recipientID := createTestTeam(t, db, "recipient", 1000000)
+	mine := createTestSquad(t, db, proposerID, 3)
+	theirs := createTestSquad(t, db, recipientID, 3)
+
+	svc := newTestSwapService(db, &config.Config{SquadMinSize: 2, SquadMaxSize: 4, SwapTTL: time.Hour})
+
+	// two for one leaves the proposer with 2, which is allowed when proposed
+	swap, err := svc.CreateSwap(ctx, proposerUser, &models.CreateSwapRequest{
+		OfferedPlayerIDs:   []int{mine[0], mine[1]},
+		RequestedPlayerIDs: []int{theirs[0]},
+	})
+	require.NoError(t, err)
+
+	// but the proposer sells another player before the recipient answers
+	_, err = db.Exec(ctx, `UPDATE players SET team_id = NULL WHERE id = $1`, mine[2])
+	require.NoError(t, err)
+
+	assert.Error(t, svc.AcceptSwap(ctx, recipientUser, swap.ID))
+
+	var owner int
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, theirs[0]).Scan(&owner))
+	assert.Equal(t, recipientID, owner)
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+	"github.com/jacobpq/soccer-manager/internal/valuation"
+)
+
+// TransferExecutor is the single place where a player changes hands for
+// money. Every transfer path (market buy, auction, offer, ...) goes through
+// it so budgets, ownership and the ledger always move together.
+type TransferExecutor struct {
+	playerRepo   *repository.PlayerRepository
+	teamRepo     *repository.TeamRepository
+	transferRepo *repository.TransferRepository
+	valuation    *valuation.Model
+	demandWindow time.Duration
+	now          func() time.Time
+}
+
+func NewTransferExecutor(p *repository.PlayerRepository, t *repository.TeamRepository, tr *repository.TransferRepository, v *valuation.Model, cfg *config.Config) *TransferExecutor {
+	return &TransferExecutor{playerRepo: p, teamRepo: t, transferRepo: tr, valuation: v, demandWindow: cfg.ValuationDemandWindow, now: utcNow}
+}
+
+// Transfer moves price from the buyer to the player's current team, the
+// player to the buyer, and records the deal. The price paid moves the
+// player's base value before he is revalued. The caller must hold row locks
+// on the player and both teams inside tx.
+func (e *TransferExecutor) Transfer(ctx context.Context, tx pgx.Tx, player *models.Player, buyerTeamID int, price float64, kind string) error {
+	if err := e.teamRepo.UpdateBudget(ctx, tx, buyerTeamID, -price); err != nil {
+		return err
+	}
+	if err := e.teamRepo.UpdateBudget(ctx, tx, player.TeamID, price); err != nil {
+		return err
+	}
+
+	demand, err := e.transferRepo.CountPositionSince(ctx, tx, player.Position, e.now().Add(-e.demandWindow))
+	if err != nil {
+		return err
+	}
+
+	base := e.valuation.Rebase(player.BaseValue, price)
+	newValue := e.valuation.Value(valuation.Inputs{
+		PlayerID: player.ID,
+		Age:      player.Age,
+		Position: player.Position,
+		Base:     base,
+		Demand:   demand,
+	})
+
+	if err := e.playerRepo.TransferOwnership(ctx, tx, player.ID, buyerTeamID, newValue, base); err != nil {
+		return err
+	}
+
+	record := &models.TransferRecord{
+		PlayerID:    player.ID,
+		Price:       price,
+		ValueBefore: player.Value,
+		ValueAfter:  newValue,
+		Kind:        kind,
+	}
+	if player.TeamID != 0 {
+		record.SellerTeamID = &player.TeamID
+	}
+	record.BuyerTeamID = &buyerTeamID
+
+	return e.transferRepo.Create(ctx, tx, record)
+}
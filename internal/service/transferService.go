@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
-	"math/rand"
+	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
 	"github.com/jacobpq/soccer-manager/internal/domain/models"
 	"github.com/jacobpq/soccer-manager/internal/locales"
 	"github.com/jacobpq/soccer-manager/internal/repository"
@@ -15,37 +17,116 @@ import (
 
 type TransferService interface {
 	BuyPlayer(ctx context.Context, userID, playerID int) error
+	TriggerReleaseClause(ctx context.Context, userID, playerID int) error
+	GetPlayer(ctx context.Context, playerID int) (*models.Player, error)
+	SetReleaseClause(ctx context.Context, userID, playerID int, amount *float64) error
 	ListPlayer(ctx context.Context, userID, playerID int, price float64) error
-	GetMarket(ctx context.Context) ([]*models.Player, error)
+	ListForTeam(ctx context.Context, teamID, playerID int, price float64) error
+	BuyForTeam(ctx context.Context, teamID, playerID int) error
+	GetMarket(ctx context.Context, filter *models.MarketFilter) (*models.MarketPage, error)
 	RemoveFromList(ctx context.Context, userID, playerID int) error
+	GetPlayerHistory(ctx context.Context, playerID int) ([]*models.TransferRecord, error)
+	GetTeamTransfers(ctx context.Context, userID int) ([]*models.TransferRecord, error)
+	GetLatestTransfers(ctx context.Context, limit int) ([]*models.TransferRecord, error)
+	ExpireListings(ctx context.Context) error
+	RequestLoan(ctx context.Context, userID int, req *models.LoanRequest) (*models.Loan, error)
+	AcceptLoan(ctx context.Context, userID, loanID int) error
+	RejectLoan(ctx context.Context, userID, loanID int) error
+	GetLoans(ctx context.Context, userID int) ([]*models.Loan, error)
+	ReturnLoans(ctx context.Context) error
 }
 
 type transferService struct {
-	db         *pgxpool.Pool
-	playerRepo *repository.PlayerRepository
-	teamRepo   *repository.TeamRepository
+	db           *pgxpool.Pool
+	playerRepo   *repository.PlayerRepository
+	teamRepo     *repository.TeamRepository
+	auctionRepo  *repository.AuctionRepository
+	transferRepo *repository.TransferRepository
+	loanRepo     *repository.LoanRepository
+	executor     *TransferExecutor
+	calendar     CalendarService
+	hooks        TransferHooks
+	cfg          *config.Config
+	now          func() time.Time
 }
 
-func NewTransferService(db *pgxpool.Pool, p *repository.PlayerRepository, t *repository.TeamRepository) TransferService {
-	return &transferService{db: db, playerRepo: p, teamRepo: t}
+func NewTransferService(db *pgxpool.Pool, p *repository.PlayerRepository, t *repository.TeamRepository, a *repository.AuctionRepository, tr *repository.TransferRepository, l *repository.LoanRepository, x *TransferExecutor, c CalendarService, h TransferHooks, cfg *config.Config) TransferService {
+	return &transferService{
+		db:           db,
+		playerRepo:   p,
+		teamRepo:     t,
+		auctionRepo:  a,
+		transferRepo: tr,
+		loanRepo:     l,
+		executor:     x,
+		calendar:     c,
+		hooks:        h,
+		cfg:          cfg,
+		now:          utcNow,
+	}
 }
 
 func (s *transferService) ListPlayer(ctx context.Context, userID, playerID int, price float64) error {
-	player, err := s.playerRepo.GetByID(ctx, s.db, playerID)
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
 	if err != nil {
-		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
 	}
+	return s.ListForTeam(ctx, team.ID, playerID, price)
+}
 
-	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+// ListForTeam lists a player on behalf of a team rather than its manager.
+// AI clubs sell through it.
+func (s *transferService) ListForTeam(ctx context.Context, teamID, playerID int, price float64) error {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+		return err
 	}
+	defer tx.Rollback(ctx)
 
-	if player.TeamID != team.ID {
+	// the lock keeps a sale from moving the player between the ownership
+	// check and the listing
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, playerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	if player.TeamID != teamID {
 		return api.ErrNotFound(locales.T(ctx, "do_not_own_player"))
 	}
 
-	return s.playerRepo.UpdateMarketStatus(ctx, s.db, playerID, price, true)
+	if err := s.requireNotInAuction(ctx, tx, playerID); err != nil {
+		return err
+	}
+	if err := s.requireNotOnLoan(ctx, tx, playerID); err != nil {
+		return err
+	}
+
+	now := s.now()
+	if !player.OnTransferList && player.DelistedAt != nil {
+		if until := player.DelistedAt.Add(s.cfg.RelistCooldown); now.Before(until) {
+			return api.ErrBadRequest(locales.T(ctx, "relist_cooldown", until.Format(time.RFC3339)))
+		}
+	}
+
+	if err := s.playerRepo.List(ctx, tx, playerID, price, now, now.Add(s.cfg.ListingTTL)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	switch {
+	case !player.OnTransferList:
+		s.hooks.PlayerListed(ctx, player, price)
+	case price < player.MarketPrice:
+		s.hooks.PriceDropped(ctx, player, player.MarketPrice, price)
+	}
+	return nil
 }
 
 func (s *transferService) RemoveFromList(ctx context.Context, userID, playerID int) error {
@@ -68,11 +149,32 @@ func (s *transferService) RemoveFromList(ctx context.Context, userID, playerID i
 		return api.ErrNotFound(locales.T(ctx, "player_not_for_sale"))
 	}
 
-	return s.playerRepo.UpdateMarketStatus(ctx, s.db, playerID, 0, false)
+	return s.playerRepo.Delist(ctx, s.db, playerID, s.now())
 }
 
-func (s *transferService) GetMarket(ctx context.Context) ([]*models.Player, error) {
-	return s.playerRepo.GetMarketPlayers(ctx, s.db)
+func (s *transferService) GetMarket(ctx context.Context, filter *models.MarketFilter) (*models.MarketPage, error) {
+	now := s.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if filter.NewToday {
+		filter.ListedSince = &today
+	}
+
+	players, err := s.playerRepo.SearchMarket(ctx, s.db, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range players {
+		p.NewToday = p.ListedAt != nil && !p.ListedAt.Before(today)
+	}
+
+	page := &models.MarketPage{Players: players}
+	if len(players) > filter.Limit {
+		page.Players = players[:filter.Limit]
+		last := page.Players[filter.Limit-1]
+		page.NextCursor = models.NewMarketCursor(filter.Sort, filter.Desc, last).Encode()
+	}
+	return page, nil
 }
 
 func (s *transferService) BuyPlayer(ctx context.Context, buyerUserID, playerID int) error {
@@ -80,22 +182,259 @@ func (s *transferService) BuyPlayer(ctx context.Context, buyerUserID, playerID i
 	if err != nil {
 		return api.ErrNotFound(locales.T(ctx, "buyer_team_not_found"))
 	}
+	return s.BuyForTeam(ctx, buyerTeam.ID, playerID)
+}
+
+// BuyForTeam buys a listed player on behalf of a team rather than its
+// manager. AI clubs buy through it.
+func (s *transferService) BuyForTeam(ctx context.Context, buyerTeamID, playerID int) error {
+	return s.purchase(ctx, buyerTeamID, playerID, models.TransferKindPurchase, func(tx pgx.Tx, player *models.Player) (float64, error) {
+		if !player.OnTransferList || (player.ListingExpiresAt != nil && !s.now().Before(*player.ListingExpiresAt)) {
+			return 0, api.ErrNotFound(locales.T(ctx, "player_not_for_sale"))
+		}
+		if err := s.requireNotInAuction(ctx, tx, player.ID); err != nil {
+			return 0, err
+		}
+		return player.MarketPrice, nil
+	})
+}
+
+// TriggerReleaseClause buys the player for the release clause whether the
+// player is listed or not.
+func (s *transferService) TriggerReleaseClause(ctx context.Context, buyerUserID, playerID int) error {
+	buyerTeam, err := s.teamRepo.GetByUserID(ctx, s.db, buyerUserID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "buyer_team_not_found"))
+	}
+
+	return s.purchase(ctx, buyerTeam.ID, playerID, models.TransferKindReleaseClause, func(tx pgx.Tx, player *models.Player) (float64, error) {
+		if player.ReleaseClause == nil || player.TeamID == 0 {
+			return 0, api.ErrNotFound(locales.T(ctx, "no_release_clause"))
+		}
+		if err := s.requireNotInAuction(ctx, tx, player.ID); err != nil {
+			return 0, err
+		}
+		return *player.ReleaseClause, nil
+	})
+}
+
+// purchase is the atomic path shared by market buys and release clauses.
+// price reads the amount to pay from the locked player, or refuses the sale.
+func (s *transferService) purchase(ctx context.Context, buyerTeamID, playerID int, kind string, price func(pgx.Tx, *models.Player) (float64, error)) error {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// everything is re-read under row locks: the player first, then both
+	// teams, so a concurrent buyer waits here and then sees the sale
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, playerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	amount, err := price(tx, player)
+	if err != nil {
+		return err
+	}
+
+	if player.TeamID == buyerTeamID {
+		return api.ErrBadRequest(locales.T(ctx, "own_player_buy"))
+	}
+
+	if err := s.requireNotOnLoan(ctx, tx, playerID); err != nil {
+		return err
+	}
+
+	teams, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, buyerTeamID, player.TeamID)
+	if err != nil {
+		return err
+	}
+
+	buyerTeam, ok := teams[buyerTeamID]
+	if !ok {
+		return api.ErrNotFound(locales.T(ctx, "buyer_team_not_found"))
+	}
+
+	if buyerTeam.Budget < amount {
+		return api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	if err := s.executor.Transfer(ctx, tx, player, buyerTeam.ID, amount, kind); err != nil {
+		return err
+	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	s.hooks.PlayerSold(ctx, player, amount)
+	return nil
+}
+
+// GetPlayer is the public profile of any player.
+func (s *transferService) GetPlayer(ctx context.Context, playerID int) (*models.Player, error) {
+	player, err := s.playerRepo.GetByID(ctx, s.db, playerID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+	return player, nil
+}
+
+// SetReleaseClause sets or, with a nil amount, removes the player's clause.
+// A loaned-out player keeps the clause his owner set.
+func (s *transferService) SetReleaseClause(ctx context.Context, userID, playerID int, amount *float64) error {
 	player, err := s.playerRepo.GetByID(ctx, s.db, playerID)
 	if err != nil {
 		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
 	}
 
-	if !player.OnTransferList {
-		return api.ErrNotFound(locales.T(ctx, "player_not_for_sale"))
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	if player.TeamID != team.ID {
+		return api.ErrForbidden(locales.T(ctx, "do_not_own_player"))
+	}
+
+	if err := s.requireNotOnLoan(ctx, s.db, playerID); err != nil {
+		return err
+	}
+
+	return s.playerRepo.SetReleaseClause(ctx, s.db, playerID, amount)
+}
+
+func (s *transferService) GetPlayerHistory(ctx context.Context, playerID int) ([]*models.TransferRecord, error) {
+	if _, err := s.playerRepo.GetByID(ctx, s.db, playerID); err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+	return s.transferRepo.GetByPlayerID(ctx, s.db, playerID)
+}
+
+func (s *transferService) GetTeamTransfers(ctx context.Context, userID int) ([]*models.TransferRecord, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+	return s.transferRepo.GetByTeamID(ctx, s.db, team.ID)
+}
+
+func (s *transferService) GetLatestTransfers(ctx context.Context, limit int) ([]*models.TransferRecord, error) {
+	return s.transferRepo.GetLatest(ctx, s.db, limit)
+}
+
+// ExpireListings delists every player whose listing has run past LISTING_TTL.
+func (s *transferService) ExpireListings(ctx context.Context) error {
+	_, err := s.playerRepo.DelistExpired(ctx, s.db, s.now())
+	return err
+}
+
+// requireNotOnLoan rejects selling a player who is loaned out. The borrowing
+// team holds the player but does not own them. Pass the open tx when the
+// player row is locked.
+func (s *transferService) requireNotOnLoan(ctx context.Context, q repository.Querier, playerID int) error {
+	onLoan, err := s.loanRepo.HasActiveLoan(ctx, q, playerID)
+	if err != nil {
+		return err
 	}
+	if onLoan {
+		return api.ErrConflict(locales.T(ctx, "player_on_loan"))
+	}
+	return nil
+}
 
-	if buyerTeam.Budget < player.MarketPrice {
-		return api.ErrNotFound(locales.T(ctx, "insufficient_funds"))
+// requireNotInAuction keeps a player from being listed or bought while an
+// auction for him is open. Auction creation checks the listing under the
+// same player lock, so the two cannot overlap.
+func (s *transferService) requireNotInAuction(ctx context.Context, q repository.Querier, playerID int) error {
+	inAuction, err := s.auctionRepo.HasOpenAuction(ctx, q, playerID)
+	if err != nil {
+		return err
+	}
+	if inAuction {
+		return api.ErrConflict(locales.T(ctx, "player_in_auction"))
+	}
+	return nil
+}
+
+// RequestLoan asks the player's team to lend him for req.Days game days.
+func (s *transferService) RequestLoan(ctx context.Context, userID int, req *models.LoanRequest) (*models.Loan, error) {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return nil, err
 	}
 
-	if player.TeamID == buyerTeam.ID {
-		return api.ErrNotFound(locales.T(ctx, "own_player_buy"))
+	if req.Days < 1 || req.Days > s.cfg.LoanMaxDays {
+		return nil, api.ErrBadRequest(locales.T(ctx, "invalid_loan_days", s.cfg.LoanMaxDays))
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	player, err := s.playerRepo.GetByID(ctx, s.db, req.PlayerID)
+	if err != nil || player.TeamID == 0 {
+		return nil, api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	if player.TeamID == team.ID {
+		return nil, api.ErrBadRequest(locales.T(ctx, "own_player_loan"))
+	}
+
+	if err := s.requireNotOnLoan(ctx, s.db, player.ID); err != nil {
+		return nil, err
+	}
+
+	if team.Budget < req.Fee {
+		return nil, api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	loan := &models.Loan{
+		PlayerID:       player.ID,
+		LenderTeamID:   player.TeamID,
+		BorrowerTeamID: team.ID,
+		Fee:            req.Fee,
+		Days:           req.Days,
+		ExpiresAt:      s.now().Add(s.cfg.LoanRequestTTL),
+	}
+	if err := s.loanRepo.Create(ctx, tx, loan); err != nil {
+		if errors.Is(err, repository.ErrLoanExists) {
+			return nil, api.ErrConflict(locales.T(ctx, "loan_exists"))
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	loan.Player = player
+	return loan, nil
+}
+
+// AcceptLoan pays the fee to the lender and moves the player to the
+// borrowing team until the loan ends. Locks are taken loan first, then
+// player, then both teams.
+func (s *transferService) AcceptLoan(ctx context.Context, userID, loanID int) error {
+	if err := s.calendar.RequireOpenWindow(ctx); err != nil {
+		return err
+	}
+
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
 	}
 
 	tx, err := s.db.Begin(ctx)
@@ -104,20 +443,202 @@ func (s *transferService) BuyPlayer(ctx context.Context, buyerUserID, playerID i
 	}
 	defer tx.Rollback(ctx)
 
-	if err := s.teamRepo.UpdateBudget(ctx, tx, buyerTeam.ID, -player.MarketPrice); err != nil {
+	loan, err := s.pendingLoan(ctx, tx, loanID)
+	if err != nil {
+		return err
+	}
+
+	if loan.LenderTeamID != team.ID {
+		if loan.BorrowerTeamID == team.ID {
+			return api.ErrForbidden(locales.T(ctx, "loan_awaiting_lender"))
+		}
+		return api.ErrNotFound(locales.T(ctx, "loan_not_found"))
+	}
+
+	player, err := s.playerRepo.GetByIDForUpdate(ctx, tx, loan.PlayerID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	if player.TeamID != loan.LenderTeamID {
+		return api.ErrConflict(locales.T(ctx, "loan_player_moved"))
+	}
+
+	if err := s.requireNotOnLoan(ctx, tx, player.ID); err != nil {
+		return err
+	}
+
+	inAuction, err := s.auctionRepo.HasOpenAuction(ctx, tx, player.ID)
+	if err != nil {
+		return err
+	}
+	if inAuction {
+		return api.ErrConflict(locales.T(ctx, "player_in_auction"))
+	}
+
+	teams, err := s.teamRepo.GetByIDsForUpdate(ctx, tx, loan.BorrowerTeamID, loan.LenderTeamID)
+	if err != nil {
+		return err
+	}
+
+	borrower, ok := teams[loan.BorrowerTeamID]
+	if !ok {
+		return api.ErrNotFound(locales.T(ctx, "buyer_team_not_found"))
+	}
+
+	if borrower.Budget < loan.Fee {
+		return api.ErrBadRequest(locales.T(ctx, "insufficient_funds"))
+	}
+
+	if err := s.teamRepo.UpdateBudget(ctx, tx, loan.BorrowerTeamID, -loan.Fee); err != nil {
 		return err
 	}
-	if err := s.teamRepo.UpdateBudget(ctx, tx, player.TeamID, player.MarketPrice); err != nil {
+	if err := s.teamRepo.UpdateBudget(ctx, tx, loan.LenderTeamID, loan.Fee); err != nil {
 		return err
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	factor := 1.1 + rand.Float64()*0.9
-	newValue := player.Value * factor
+	if err := s.playerRepo.MoveToTeam(ctx, tx, player.ID, &loan.BorrowerTeamID); err != nil {
+		return err
+	}
 
-	if err := s.playerRepo.TransferOwnership(ctx, tx, playerID, buyerTeam.ID, newValue); err != nil {
+	now := s.now()
+	endsAt := now.Add(time.Duration(loan.Days) * s.cfg.GameDayLength)
+	if err := s.loanRepo.Activate(ctx, tx, loan.ID, now, endsAt); err != nil {
+		return err
+	}
+	if err := s.loanRepo.CancelPendingForPlayer(ctx, tx, player.ID, now); err != nil {
+		return err
+	}
+
+	record := &models.TransferRecord{
+		PlayerID:     player.ID,
+		SellerTeamID: &loan.LenderTeamID,
+		BuyerTeamID:  &loan.BorrowerTeamID,
+		Price:        loan.Fee,
+		ValueBefore:  player.Value,
+		ValueAfter:   player.Value,
+		Kind:         models.TransferKindLoan,
+	}
+	if err := s.transferRepo.Create(ctx, tx, record); err != nil {
 		return err
 	}
 
 	return tx.Commit(ctx)
 }
+
+// RejectLoan closes a pending request. The lender rejects it; the borrower
+// withdraws it.
+func (s *transferService) RejectLoan(ctx context.Context, userID, loanID int) error {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	loan, err := s.pendingLoan(ctx, tx, loanID)
+	if err != nil {
+		return err
+	}
+
+	status := models.LoanRejected
+	switch team.ID {
+	case loan.LenderTeamID:
+	case loan.BorrowerTeamID:
+		status = models.LoanCancelled
+	default:
+		return api.ErrNotFound(locales.T(ctx, "loan_not_found"))
+	}
+
+	if err := s.loanRepo.SetStatus(ctx, tx, loan.ID, status, s.now()); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *transferService) GetLoans(ctx context.Context, userID int) ([]*models.Loan, error) {
+	team, err := s.teamRepo.GetByUserID(ctx, s.db, userID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "team_not_found"))
+	}
+	return s.loanRepo.GetByTeamID(ctx, s.db, team.ID)
+}
+
+// ReturnLoans sends every loaned player whose loan has ended back to the
+// lender and expires stale requests. One failed return does not stop the rest.
+func (s *transferService) ReturnLoans(ctx context.Context) error {
+	now := s.now()
+	if _, err := s.loanRepo.ExpirePending(ctx, s.db, now); err != nil {
+		return err
+	}
+
+	ids, err := s.loanRepo.GetDueIDs(ctx, s.db, now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, id := range ids {
+		if err := s.returnLoan(ctx, id); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *transferService) returnLoan(ctx context.Context, loanID int) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	loan, err := s.loanRepo.GetByIDForUpdate(ctx, tx, loanID)
+	if err != nil {
+		return err
+	}
+	if loan.Status != models.LoanActive {
+		return nil
+	}
+
+	if _, err := s.playerRepo.GetByIDForUpdate(ctx, tx, loan.PlayerID); err != nil {
+		return err
+	}
+
+	// a deleted lender leaves the player in the free-agent pool
+	var lender *int
+	if loan.LenderTeamID != 0 {
+		lender = &loan.LenderTeamID
+	}
+	if err := s.playerRepo.MoveToTeam(ctx, tx, loan.PlayerID, lender); err != nil {
+		return err
+	}
+	if err := s.loanRepo.MarkReturned(ctx, tx, loan.ID, s.now()); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// pendingLoan locks the loan and checks it can still be answered.
+func (s *transferService) pendingLoan(ctx context.Context, tx pgx.Tx, loanID int) (*models.Loan, error) {
+	loan, err := s.loanRepo.GetByIDForUpdate(ctx, tx, loanID)
+	if err != nil {
+		return nil, api.ErrNotFound(locales.T(ctx, "loan_not_found"))
+	}
+
+	if loan.Status != models.LoanPending {
+		return nil, api.ErrConflict(locales.T(ctx, "loan_closed"))
+	}
+
+	if !s.now().Before(loan.ExpiresAt) {
+		return nil, api.ErrConflict(locales.T(ctx, "loan_expired"))
+	}
+
+	return loan, nil
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+	"github.com/jacobpq/soccer-manager/internal/valuation"
+)
+
+// newTestDB connects to TEST_DATABASE_URL and loads schema.sql into a fresh
+// schema that is dropped when the test ends. Tests are skipped without it.
+func newTestDB(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	ctx := context.Background()
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+
+	admin, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	_, err = admin.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	require.NoError(t, err)
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+	cfg.MaxConns = 32
+
+	db, err := pgxpool.NewWithConfig(ctx, cfg)
+	require.NoError(t, err)
+
+	ddl, err := os.ReadFile("../../schema.sql")
+	require.NoError(t, err)
+	_, err = db.Exec(ctx, string(ddl))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		db.Close()
+		admin.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		admin.Close()
+	})
+	return db
+}
+
+// createTestTeam inserts a user with a team and returns their ids.
+func createTestTeam(t *testing.T, db *pgxpool.Pool, name string, budget float64) (userID, teamID int) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := db.QueryRow(ctx,
+		`INSERT INTO users (email, password_hash) VALUES ($1, 'x') RETURNING id`,
+		name+"@test.com").Scan(&userID)
+	require.NoError(t, err)
+
+	err = db.QueryRow(ctx,
+		`INSERT INTO teams (user_id, name, country, budget) VALUES ($1, $2, 'Georgia', $3) RETURNING id`,
+		userID, name, budget).Scan(&teamID)
+	require.NoError(t, err)
+	return userID, teamID
+}
+
+// newTestValuation has no random spread, so values are exact.
+func newTestValuation(t *testing.T) *valuation.Model {
+	t.Helper()
+	m, err := valuation.NewModel(&config.Config{
+		ValuationPeakAgeFrom: 24,
+		ValuationPeakAgeTo:   29,
+		ValuationAgeDecline:  0.1,
+		ValuationPositions:   "AT=1.2",
+		ValuationPriceWeight: 0.5,
+	}, rand.NewSource(1))
+	require.NoError(t, err)
+	return m
+}
+
+func newTestExecutor(t *testing.T) *TransferExecutor {
+	return NewTransferExecutor(repository.NewPlayerRepository(), repository.NewTeamRepository(), repository.NewTransferRepository(),
+		newTestValuation(t), &config.Config{ValuationDemandWindow: 24 * time.Hour})
+}
+
+// newTestCalendar does not enforce transfer windows.
+func newTestCalendar(db *pgxpool.Pool) CalendarService {
+	return NewCalendarService(repository.NewWindowRepository(db), &config.Config{})
+}
+
+func newTestWatchlist(db *pgxpool.Pool) WatchlistService {
+	return NewWatchlistService(db, repository.NewWatchlistRepository(), repository.NewPlayerRepository(), &config.Config{WatchlistMaxSize: 10})
+}
+
+func newTestTransferService(t *testing.T, db *pgxpool.Pool) TransferService {
+	return NewTransferService(db, repository.NewPlayerRepository(), repository.NewTeamRepository(),
+		repository.NewAuctionRepository(), repository.NewTransferRepository(), repository.NewLoanRepository(), newTestExecutor(t), newTestCalendar(db),
+		newTestWatchlist(db), &config.Config{ListingTTL: 24 * time.Hour, RelistCooldown: time.Hour})
+}
+
+func TestTransferService_BuyPlayer_Concurrent(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	const buyers = 20
+	const price = 1500000.0
+
+	_, sellerTeamID := createTestTeam(t, db, "seller", 5000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value, on_transfer_list)
+		VALUES ($1, 'Khvicha', 'Kvaratskhelia', 'Georgia', 24, 'AT', 1000000, $2, true)
+		RETURNING id`, sellerTeamID, price).Scan(&playerID)
+	require.NoError(t, err)
+
+	buyerUserIDs := make([]int, buyers)
+	buyerTeamIDs := make(map[int]bool, buyers)
+	for i := range buyerUserIDs {
+		var teamID int
+		buyerUserIDs[i], teamID = createTestTeam(t, db, fmt.Sprintf("buyer%d", i), 2000000)
+		buyerTeamIDs[teamID] = true
+	}
+
+	svc := newTestTransferService(t, db)
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make([]error, buyers)
+
+	for i, userID := range buyerUserIDs {
+		wg.Add(1)
+		go func(i, userID int) {
+			defer wg.Done()
+			<-start
+			errs[i] = svc.BuyPlayer(ctx, userID, playerID)
+		}(i, userID)
+	}
+	close(start)
+	wg.Wait()
+
+	winners := 0
+	for _, err := range errs {
+		if err == nil {
+			winners++
+		}
+	}
+	assert.Equal(t, 1, winners, "exactly one buyer must win")
+
+	var ownerID int
+	require.NoError(t, db.QueryRow(ctx, `SELECT team_id FROM players WHERE id = $1`, playerID).Scan(&ownerID))
+	assert.True(t, buyerTeamIDs[ownerID], "player must belong to one of the buyers")
+
+	var sellerBudget, totalBudget float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, sellerTeamID).Scan(&sellerBudget))
+	require.NoError(t, db.QueryRow(ctx, `SELECT SUM(budget) FROM teams`).Scan(&totalBudget))
+	assert.Equal(t, 5000000+price, sellerBudget, "seller is paid once")
+	assert.Equal(t, 5000000+buyers*2000000.0, totalBudget, "no money is created or lost")
+
+	history, err := svc.GetPlayerHistory(ctx, playerID)
+	require.NoError(t, err)
+	require.Len(t, history, 1, "one ledger row per sale")
+	assert.Equal(t, sellerTeamID, *history[0].SellerTeamID)
+	assert.Equal(t, ownerID, *history[0].BuyerTeamID)
+	assert.Equal(t, price, history[0].Price)
+	assert.Equal(t, models.TransferKindPurchase, history[0].Kind)
+}
+
+func TestTransferService_GetMarket_Pagination(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	_, teamID := createTestTeam(t, db, "seller", 5000000)
+	prices := []float64{900000, 1200000, 1200000, 700000, 2500000}
+	for i, price := range prices {
+		_, err := db.Exec(ctx, `
+			INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value, on_transfer_list, listed_at)
+			VALUES ($1, 'Player', $2, 'Georgia', 25, 'MD', 1000000, $3, true, CURRENT_TIMESTAMP)`,
+			teamID, fmt.Sprint(i), price)
+		require.NoError(t, err)
+	}
+
+	svc := newTestTransferService(t, db)
+
+	filter := &models.MarketFilter{Sort: models.MarketSortPrice, Limit: 2}
+	var got []float64
+	seen := map[int]bool{}
+	for {
+		page, err := svc.GetMarket(ctx, filter)
+		require.NoError(t, err)
+		for _, p := range page.Players {
+			assert.False(t, seen[p.ID], "player returned twice")
+			seen[p.ID] = true
+			got = append(got, p.MarketPrice)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		filter.Cursor, err = models.DecodeMarketCursor(page.NextCursor)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []float64{700000, 900000, 1200000, 1200000, 2500000}, got)
+}
+
+func TestTransferService_ListingExpiryAndCooldown(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	userID, teamID := createTestTeam(t, db, "seller", 5000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Budu', 'Zivzivadze', 'Georgia', 30, 'AT', 1000000, 0)
+		RETURNING id`, teamID).Scan(&playerID)
+	require.NoError(t, err)
+
+	svc := newTestTransferService(t, db).(*transferService)
+	start := time.Now().UTC()
+	svc.now = func() time.Time { return start }
+
+	require.NoError(t, svc.ListPlayer(ctx, userID, playerID, 1500000))
+
+	page, err := svc.GetMarket(ctx, &models.MarketFilter{Sort: models.MarketSortListedAt, Limit: 10, NewToday: true})
+	require.NoError(t, err)
+	require.Len(t, page.Players, 1)
+	assert.True(t, page.Players[0].NewToday)
+	require.NotNil(t, page.Players[0].ListingExpiresAt)
+
+	// the listing TTL is one day and the cooldown one hour
+	svc.now = func() time.Time { return start.Add(25 * time.Hour) }
+	require.NoError(t, svc.ExpireListings(ctx))
+
+	player, err := repository.NewPlayerRepository().GetByID(ctx, db, playerID)
+	require.NoError(t, err)
+	assert.False(t, player.OnTransferList)
+
+	svc.now = func() time.Time { return start.Add(25*time.Hour + 30*time.Minute) }
+	assert.Error(t, svc.ListPlayer(ctx, userID, playerID, 1500000), "relist during cooldown")
+
+	svc.now = func() time.Time { return start.Add(27 * time.Hour) }
+	assert.NoError(t, svc.ListPlayer(ctx, userID, playerID, 1500000))
+}
+
+func TestTransferService_LoanLifecycle(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	lenderUser, lenderID := createTestTeam(t, db, "lender", 1000000)
+	borrowerUser, borrowerID := createTestTeam(t, db, "borrower", 1000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Giorgi', 'Kochorashvili', 'Georgia', 25, 'MF', 1000000, 0)
+		RETURNING id`, lenderID).Scan(&playerID)
+	require.NoError(t, err)
+
+	cfg := &config.Config{ListingTTL: 24 * time.Hour, GameDayLength: time.Hour, LoanMaxDays: 10, LoanRequestTTL: time.Hour}
+	svc := NewTransferService(db, repository.NewPlayerRepository(), repository.NewTeamRepository(),
+		repository.NewAuctionRepository(), repository.NewTransferRepository(), repository.NewLoanRepository(),
+		newTestExecutor(t), newTestCalendar(db), newTestWatchlist(db), cfg).(*transferService)
+	start := time.Now().UTC()
+	svc.now = func() time.Time { return start }
+
+	loan, err := svc.RequestLoan(ctx, borrowerUser, &models.LoanRequest{PlayerID: playerID, Days: 3, Fee: 100000})
+	require.NoError(t, err)
+
+	assert.Error(t, svc.AcceptLoan(ctx, borrowerUser, loan.ID), "borrower cannot accept")
+	require.NoError(t, svc.AcceptLoan(ctx, lenderUser, loan.ID))
+
+	players := repository.NewPlayerRepository()
+	player, err := players.GetByID(ctx, db, playerID)
+	require.NoError(t, err)
+	assert.Equal(t, borrowerID, player.TeamID)
+
+	assert.Error(t, svc.ListPlayer(ctx, borrowerUser, playerID, 500000), "loaned player cannot be listed")
+
+	var lenderBudget, borrowerBudget float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, lenderID).Scan(&lenderBudget))
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, borrowerID).Scan(&borrowerBudget))
+	assert.Equal(t, 1100000.0, lenderBudget)
+	assert.Equal(t, 900000.0, borrowerBudget)
+
+	// not due yet
+	svc.now = func() time.Time { return start.Add(2 * time.Hour) }
+	require.NoError(t, svc.ReturnLoans(ctx))
+	player, _ = players.GetByID(ctx, db, playerID)
+	assert.Equal(t, borrowerID, player.TeamID)
+
+	svc.now = func() time.Time { return start.Add(3 * time.Hour) }
+	require.NoError(t, svc.ReturnLoans(ctx))
+	player, _ = players.GetByID(ctx, db, playerID)
+	assert.Equal(t, lenderID, player.TeamID)
+
+	assert.NoError(t, svc.ListPlayer(ctx, lenderUser, playerID, 500000))
+}
+
+func TestTransferService_TriggerReleaseClause(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUser, sellerID := createTestTeam(t, db, "seller", 1000000)
+	buyerUser, buyerID := createTestTeam(t, db, "buyer", 5000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Khvicha', 'Kvaratskhelia', 'Georgia', 24, 'AT', 1000000, 0)
+		RETURNING id`, sellerID).Scan(&playerID)
+	require.NoError(t, err)
+
+	svc := newTestTransferService(t, db)
+
+	assert.Error(t, svc.TriggerReleaseClause(ctx, buyerUser, playerID), "no clause set")
+
+	clause := 3000000.0
+	assert.Error(t, svc.SetReleaseClause(ctx, buyerUser, playerID, &clause), "only the owner sets the clause")
+	require.NoError(t, svc.SetReleaseClause(ctx, sellerUser, playerID, &clause))
+
+	profile, err := svc.GetPlayer(ctx, playerID)
+	require.NoError(t, err)
+	require.NotNil(t, profile.ReleaseClause)
+	assert.Equal(t, clause, *profile.ReleaseClause)
+
+	assert.Error(t, svc.TriggerReleaseClause(ctx, sellerUser, playerID), "own player")
+	require.NoError(t, svc.TriggerReleaseClause(ctx, buyerUser, playerID))
+
+	profile, err = svc.GetPlayer(ctx, playerID)
+	require.NoError(t, err)
+	assert.Equal(t, buyerID, profile.TeamID)
+	assert.Nil(t, profile.ReleaseClause, "a transfer clears the clause")
+
+	var sellerBudget, buyerBudget float64
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, sellerID).Scan(&sellerBudget))
+	require.NoError(t, db.QueryRow(ctx, `SELECT budget FROM teams WHERE id = $1`, buyerID).Scan(&buyerBudget))
+	assert.Equal(t, 4000000.0, sellerBudget)
+	assert.Equal(t, 2000000.0, buyerBudget)
+}
+
+func TestTransferService_AuctionedPlayerNotForSale(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUser, sellerID := createTestTeam(t, db, "seller", 1000000)
+	buyerUser, _ := createTestTeam(t, db, "buyer", 5000000)
+	player := createTestSquad(t, db, sellerID, 1)[0]
+
+	svc := newTestTransferService(t, db)
+	require.NoError(t, svc.ListPlayer(ctx, sellerUser, player, 1000000))
+
+	// an auction opened before the listing check ran
+	_, err := db.Exec(ctx, `
+		INSERT INTO auctions (player_id, seller_team_id, reserve_price, min_increment, ends_at)
+		VALUES ($1, $2, 1000000, 100000, NOW() + INTERVAL '1 hour')`, player, sellerID)
+	require.NoError(t, err)
+
+	assert.Error(t, svc.ListPlayer(ctx, sellerUser, player, 900000))
+	assert.Error(t, svc.BuyPlayer(ctx, buyerUser, player))
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+	"github.com/jacobpq/soccer-manager/internal/valuation"
+)
+
+type ValuationService interface {
+	Recompute(ctx context.Context) error
+}
+
+type valuationService struct {
+	db           *pgxpool.Pool
+	playerRepo   *repository.PlayerRepository
+	transferRepo *repository.TransferRepository
+	valuation    *valuation.Model
+	demandWindow time.Duration
+	now          func() time.Time
+}
+
+func NewValuationService(db *pgxpool.Pool, p *repository.PlayerRepository, tr *repository.TransferRepository, v *valuation.Model, cfg *config.Config) ValuationService {
+	return &valuationService{
+		db:           db,
+		playerRepo:   p,
+		transferRepo: tr,
+		valuation:    v,
+		demandWindow: cfg.ValuationDemandWindow,
+		now:          utcNow,
+	}
+}
+
+// Recompute revalues every player from their base value, age, position and
+// the current demand for that position. Base values are left alone, so
+// running it repeatedly does not compound, and players transferred while it
+// runs keep the value the transfer set.
+func (s *valuationService) Recompute(ctx context.Context) error {
+	demand, err := s.transferRepo.CountByPositionSince(ctx, s.db, s.now().Add(-s.demandWindow))
+	if err != nil {
+		return err
+	}
+
+	players, err := s.playerRepo.GetAllForValuation(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int, 0, len(players))
+	values := make([]float64, 0, len(players))
+	olds := make([]float64, 0, len(players))
+	bases := make([]float64, 0, len(players))
+	for _, p := range players {
+		value := s.valuation.Value(valuation.Inputs{
+			PlayerID: p.ID,
+			Age:      p.Age,
+			Position: p.Position,
+			Base:     p.BaseValue,
+			Demand:   demand[p.Position],
+		})
+		if value == p.Value {
+			continue
+		}
+		ids = append(ids, p.ID)
+		values = append(values, value)
+		olds = append(olds, p.Value)
+		bases = append(bases, p.BaseValue)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return s.playerRepo.UpdateValues(ctx, s.db, ids, values, olds, bases)
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func TestValuationService_Recompute(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	_, teamID := createTestTeam(t, db, "club", 5000000)
+
+	insert := func(age int, position string) int {
+		var id int
+		err := db.QueryRow(ctx, `
+			INSERT INTO players (team_id, first_name, last_name, country, age, position, value, base_value, market_value)
+			VALUES ($1, 'Test', 'Player', 'Georgia', $2, $3, 1000000, 1000000, 0)
+			RETURNING id`, teamID, age, position).Scan(&id)
+		require.NoError(t, err)
+		return id
+	}
+	striker := insert(26, "AT")
+	veteran := insert(32, "DF")
+
+	svc := NewValuationService(db, repository.NewPlayerRepository(), repository.NewTransferRepository(),
+		newTestValuation(t), &config.Config{}).(*valuationService)
+
+	// running twice must not compound
+	require.NoError(t, svc.Recompute(ctx))
+	require.NoError(t, svc.Recompute(ctx))
+
+	players := repository.NewPlayerRepository()
+	p, err := players.GetByID(ctx, db, striker)
+	require.NoError(t, err)
+	assert.Equal(t, 1200000.0, p.Value)
+	assert.Equal(t, 1000000.0, p.BaseValue)
+
+	p, err = players.GetByID(ctx, db, veteran)
+	require.NoError(t, err)
+	assert.Equal(t, 700000.0, p.Value)
+
+	// values computed from a player the job no longer sees are dropped,
+	// whether the transfer moved his base value or only his value
+	require.NoError(t, players.UpdateValues(ctx, db, []int{veteran}, []float64{1}, []float64{700000}, []float64{900000}))
+	require.NoError(t, players.UpdateValues(ctx, db, []int{veteran}, []float64{1}, []float64{650000}, []float64{1000000}))
+	p, err = players.GetByID(ctx, db, veteran)
+	require.NoError(t, err)
+	assert.Equal(t, 700000.0, p.Value)
+}
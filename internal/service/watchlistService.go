@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/jacobpq/soccer-manager/internal/api"
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/locales"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+// TransferHooks is told about market events once they are committed. Hooks
+// must not fail the action that triggered them.
+type TransferHooks interface {
+	PlayerListed(ctx context.Context, player *models.Player, price float64)
+	PriceDropped(ctx context.Context, player *models.Player, oldPrice, newPrice float64)
+	PlayerSold(ctx context.Context, player *models.Player, price float64)
+}
+
+type WatchlistService interface {
+	TransferHooks
+	Watch(ctx context.Context, userID, playerID int) error
+	Unwatch(ctx context.Context, userID, playerID int) error
+	GetWatchlist(ctx context.Context, userID int) ([]*models.WatchlistEntry, error)
+	GetNotifications(ctx context.Context, userID int) ([]*models.Notification, error)
+	MarkNotificationRead(ctx context.Context, userID, notificationID int) error
+}
+
+type watchlistService struct {
+	db            *pgxpool.Pool
+	watchlistRepo *repository.WatchlistRepository
+	playerRepo    *repository.PlayerRepository
+	cfg           *config.Config
+	now           func() time.Time
+}
+
+func NewWatchlistService(db *pgxpool.Pool, w *repository.WatchlistRepository, p *repository.PlayerRepository, cfg *config.Config) WatchlistService {
+	return &watchlistService{db: db, watchlistRepo: w, playerRepo: p, cfg: cfg, now: utcNow}
+}
+
+func (s *watchlistService) Watch(ctx context.Context, userID, playerID int) error {
+	if _, err := s.playerRepo.GetByID(ctx, s.db, playerID); err != nil {
+		return api.ErrNotFound(locales.T(ctx, "player_not_found"))
+	}
+
+	count, err := s.watchlistRepo.Count(ctx, s.db, userID)
+	if err != nil {
+		return err
+	}
+	if count >= s.cfg.WatchlistMaxSize {
+		return api.ErrConflict(locales.T(ctx, "watchlist_full", s.cfg.WatchlistMaxSize))
+	}
+
+	return s.watchlistRepo.Add(ctx, s.db, userID, playerID)
+}
+
+func (s *watchlistService) Unwatch(ctx context.Context, userID, playerID int) error {
+	n, err := s.watchlistRepo.Remove(ctx, s.db, userID, playerID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return api.ErrNotFound(locales.T(ctx, "player_not_watched"))
+	}
+	return nil
+}
+
+func (s *watchlistService) GetWatchlist(ctx context.Context, userID int) ([]*models.WatchlistEntry, error) {
+	return s.watchlistRepo.GetByUserID(ctx, s.db, userID)
+}
+
+func (s *watchlistService) GetNotifications(ctx context.Context, userID int) ([]*models.Notification, error) {
+	return s.watchlistRepo.GetNotifications(ctx, s.db, userID, models.NotificationsLimit)
+}
+
+func (s *watchlistService) MarkNotificationRead(ctx context.Context, userID, notificationID int) error {
+	n, err := s.watchlistRepo.MarkNotificationRead(ctx, s.db, userID, notificationID, s.now())
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return api.ErrNotFound(locales.T(ctx, "notification_not_found"))
+	}
+	return nil
+}
+
+func (s *watchlistService) PlayerListed(ctx context.Context, player *models.Player, price float64) {
+	s.notify(ctx, player.ID, models.NotificationListed, &price, nil)
+}
+
+func (s *watchlistService) PriceDropped(ctx context.Context, player *models.Player, oldPrice, newPrice float64) {
+	s.notify(ctx, player.ID, models.NotificationPriceDrop, &newPrice, &oldPrice)
+}
+
+func (s *watchlistService) PlayerSold(ctx context.Context, player *models.Player, price float64) {
+	s.notify(ctx, player.ID, models.NotificationSold, &price, nil)
+}
+
+func (s *watchlistService) notify(ctx context.Context, playerID int, kind string, price, oldPrice *float64) {
+	if err := s.watchlistRepo.NotifyWatchers(ctx, s.db, playerID, kind, price, oldPrice); err != nil {
+		log.Printf("Failed to notify watchers of player %d: %v", playerID, err)
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+	"github.com/jacobpq/soccer-manager/internal/repository"
+)
+
+func TestWatchlistService_MarketNotifications(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUser, sellerID := createTestTeam(t, db, "seller", 1000000)
+	scoutUser, _ := createTestTeam(t, db, "scout", 5000000)
+	buyerUser, _ := createTestTeam(t, db, "buyer", 5000000)
+
+	var playerID int
+	err := db.QueryRow(ctx, `
+		INSERT INTO players (team_id, first_name, last_name, country, age, position, value, market_value)
+		VALUES ($1, 'Georges', 'Mikautadze', 'Georgia', 24, 'AT', 1000000, 0)
+		RETURNING id`, sellerID).Scan(&playerID)
+	require.NoError(t, err)
+
+	watchlist := newTestWatchlist(db)
+	svc := newTestTransferService(t, db)
+
+	require.NoError(t, watchlist.Watch(ctx, scoutUser, playerID))
+
+	entries, err := watchlist.GetWatchlist(ctx, scoutUser)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.WatchStatusNotListed, entries[0].Status)
+	assert.Equal(t, "seller", entries[0].TeamName)
+
+	require.NoError(t, svc.ListPlayer(ctx, sellerUser, playerID, 2000000))
+	require.NoError(t, svc.ListPlayer(ctx, sellerUser, playerID, 2500000))
+	require.NoError(t, svc.ListPlayer(ctx, sellerUser, playerID, 1500000))
+
+	entries, err = watchlist.GetWatchlist(ctx, scoutUser)
+	require.NoError(t, err)
+	assert.Equal(t, models.WatchStatusListed, entries[0].Status)
+	require.NotNil(t, entries[0].Price)
+	assert.Equal(t, 1500000.0, *entries[0].Price)
+
+	require.NoError(t, svc.BuyPlayer(ctx, buyerUser, playerID))
+
+	notifications, err := watchlist.GetNotifications(ctx, scoutUser)
+	require.NoError(t, err)
+	require.Len(t, notifications, 3, "a price rise is not announced")
+	assert.Equal(t, models.NotificationSold, notifications[0].Kind)
+	assert.Equal(t, models.NotificationPriceDrop, notifications[1].Kind)
+	require.NotNil(t, notifications[1].OldPrice)
+	assert.Equal(t, 2500000.0, *notifications[1].OldPrice)
+	assert.Equal(t, models.NotificationListed, notifications[2].Kind)
+
+	require.NoError(t, watchlist.MarkNotificationRead(ctx, scoutUser, notifications[0].ID))
+	assert.Error(t, watchlist.MarkNotificationRead(ctx, buyerUser, notifications[0].ID), "belongs to another user")
+
+	require.NoError(t, watchlist.Unwatch(ctx, scoutUser, playerID))
+	assert.Error(t, watchlist.Unwatch(ctx, scoutUser, playerID))
+}
+
+func TestWatchlistService_AuctionSaleNotifies(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	sellerUser, sellerID := createTestTeam(t, db, "seller", 1000000)
+	scoutUser, _ := createTestTeam(t, db, "scout", 5000000)
+	bidderUser, _ := createTestTeam(t, db, "bidder", 5000000)
+	player := createTestSquad(t, db, sellerID, 1)[0]
+
+	watchlist := newTestWatchlist(db)
+	require.NoError(t, watchlist.Watch(ctx, scoutUser, player))
+
+	auctions := NewAuctionService(db, repository.NewAuctionRepository(), repository.NewPlayerRepository(), repository.NewTeamRepository(),
+		repository.NewLoanRepository(), newTestExecutor(t), newTestCalendar(db), watchlist,
+		&config.Config{AuctionMinIncrement: 100000, AuctionMinDuration: time.Minute, AuctionMaxDuration: time.Hour}).(*auctionService)
+
+	start := time.Now().UTC()
+	auctions.now = func() time.Time { return start }
+
+	auction, err := auctions.CreateAuction(ctx, sellerUser, &models.CreateAuctionRequest{
+		PlayerID:     player,
+		ReservePrice: 1000000,
+		EndsAt:       start.Add(30 * time.Minute),
+	})
+	require.NoError(t, err)
+	_, err = auctions.PlaceBid(ctx, bidderUser, auction.ID, 1200000)
+	require.NoError(t, err)
+
+	auctions.now = func() time.Time { return start.Add(time.Hour) }
+	require.NoError(t, auctions.SettleDue(ctx))
+
+	notifications, err := watchlist.GetNotifications(ctx, scoutUser)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, models.NotificationSold, notifications[0].Kind)
+	require.NotNil(t, notifications[0].Price)
+	assert.Equal(t, 1200000.0, *notifications[0].Price)
+}
@@ -0,0 +1,120 @@
+// Package throttle slows down and locks out repeated failed logins, counted
+// both per account and per client IP.
+package throttle
+
+import (
+	"context"
+	"time"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+)
+
+type Limiter struct {
+	store       Store
+	accountMax  int
+	ipMax       int
+	window      time.Duration
+	lockout     time.Duration
+	backoffBase time.Duration
+	backoffMax  time.Duration
+	now         func() time.Time
+}
+
+func NewLimiter(store Store, cfg *config.Config) *Limiter {
+	return &Limiter{
+		store:       store,
+		accountMax:  cfg.LoginMaxAttempts,
+		ipMax:       cfg.LoginIPMaxAttempts,
+		window:      cfg.LoginAttemptWindow,
+		lockout:     cfg.LoginLockoutDuration,
+		backoffBase: cfg.LoginBackoffBase,
+		backoffMax:  cfg.LoginBackoffMax,
+		now:         time.Now,
+	}
+}
+
+func accountKey(account string) string { return "account:" + account }
+func ipKey(ip string) string           { return "ip:" + ip }
+
+// Check returns how long the client has to wait before the next attempt,
+// or zero if it may try now.
+func (l *Limiter) Check(ctx context.Context, account, ip string) (time.Duration, error) {
+	var wait time.Duration
+	for _, key := range l.keys(account, ip) {
+		e, err := l.store.Get(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		if e == nil {
+			continue
+		}
+
+		now := l.now()
+		var until time.Time
+		if e.LockedUntil != nil && e.LockedUntil.After(now) {
+			until = *e.LockedUntil
+		} else if e.LastFailureAt.After(now.Add(-l.window)) {
+			until = e.LastFailureAt.Add(l.backoff(e.Failures))
+		}
+
+		if d := until.Sub(now); d > wait {
+			wait = d
+		}
+	}
+	return wait, nil
+}
+
+// Fail records a failed attempt and locks the account or IP once it reaches
+// its threshold. It returns the wait before the next attempt is allowed.
+func (l *Limiter) Fail(ctx context.Context, account, ip string) (time.Duration, error) {
+	now := l.now()
+	limits := map[string]int{accountKey(account): l.accountMax, ipKey(ip): l.ipMax}
+
+	for _, key := range l.keys(account, ip) {
+		e, err := l.store.RecordFailure(ctx, key, now, l.window)
+		if err != nil {
+			return 0, err
+		}
+
+		if max := limits[key]; max > 0 && e.Failures >= max {
+			if err := l.store.Lock(ctx, key, now.Add(l.lockout)); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return l.Check(ctx, account, ip)
+}
+
+// Succeed clears the account counter. The IP counter is left alone so an
+// attacker cannot reset it by logging into an account of their own.
+func (l *Limiter) Succeed(ctx context.Context, account string) error {
+	return l.store.Reset(ctx, accountKey(account))
+}
+
+func (l *Limiter) Unlock(ctx context.Context, account string) error {
+	return l.store.Reset(ctx, accountKey(account))
+}
+
+func (l *Limiter) keys(account, ip string) []string {
+	keys := []string{accountKey(account)}
+	if ip != "" {
+		keys = append(keys, ipKey(ip))
+	}
+	return keys
+}
+
+// backoff doubles the delay with every failure: base, 2*base, 4*base...
+func (l *Limiter) backoff(failures int) time.Duration {
+	if failures <= 0 || l.backoffBase <= 0 {
+		return 0
+	}
+
+	d := l.backoffBase
+	for i := 1; i < failures && d < l.backoffMax; i++ {
+		d *= 2
+	}
+	if d > l.backoffMax {
+		d = l.backoffMax
+	}
+	return d
+}
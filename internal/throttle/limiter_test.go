@@ -0,0 +1,67 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLimiter(now *time.Time) *Limiter {
+	return &Limiter{
+		store:       NewMemoryStore(),
+		accountMax:  3,
+		ipMax:       10,
+		window:      15 * time.Minute,
+		lockout:     10 * time.Minute,
+		backoffBase: time.Second,
+		backoffMax:  30 * time.Second,
+		now:         func() time.Time { return *now },
+	}
+}
+
+func TestLimiter_BackoffAndLockout(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	l := newTestLimiter(&now)
+
+	wait, err := l.Check(ctx, "a@test.com", "10.0.0.1")
+	assert.NoError(t, err)
+	assert.Zero(t, wait)
+
+	wait, _ = l.Fail(ctx, "a@test.com", "10.0.0.1")
+	assert.Equal(t, time.Second, wait)
+
+	now = now.Add(time.Second)
+	wait, _ = l.Fail(ctx, "a@test.com", "10.0.0.1")
+	assert.Equal(t, 2*time.Second, wait)
+
+	now = now.Add(2 * time.Second)
+	wait, _ = l.Fail(ctx, "a@test.com", "10.0.0.1")
+	assert.Equal(t, 10*time.Minute, wait, "third failure locks the account")
+
+	now = now.Add(5 * time.Minute)
+	wait, _ = l.Check(ctx, "a@test.com", "10.0.0.2")
+	assert.Equal(t, 5*time.Minute, wait, "lockout follows the account to another IP")
+
+	assert.NoError(t, l.Unlock(ctx, "a@test.com"))
+	wait, _ = l.Check(ctx, "a@test.com", "10.0.0.2")
+	assert.Zero(t, wait)
+}
+
+func TestLimiter_SucceedKeepsIPCounter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	l := newTestLimiter(&now)
+
+	l.Fail(ctx, "victim@test.com", "10.0.0.1")
+	assert.NoError(t, l.Succeed(ctx, "victim@test.com"))
+
+	wait, _ := l.Check(ctx, "other@test.com", "10.0.0.1")
+	assert.Equal(t, time.Second, wait)
+
+	now = now.Add(time.Hour)
+	wait, _ = l.Check(ctx, "other@test.com", "10.0.0.1")
+	assert.Zero(t, wait, "failures outside the window are forgotten")
+}
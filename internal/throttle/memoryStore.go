@@ -0,0 +1,65 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+// MemoryStore keeps counters in process memory. Counters are lost on restart
+// and not shared between instances, which is fine for a single node or dev.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]models.LoginAttempt
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]models.LoginAttempt)}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (*models.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	return &e, nil
+}
+
+func (s *MemoryStore) RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*models.LoginAttempt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok || e.LastFailureAt.Before(now.Add(-window)) {
+		e = models.LoginAttempt{Key: key, LockedUntil: e.LockedUntil}
+	}
+	e.Failures++
+	e.LastFailureAt = now
+
+	s.entries[key] = e
+	return &e, nil
+}
+
+func (s *MemoryStore) Lock(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.entries[key]
+	e.Key = key
+	e.LockedUntil = &until
+	s.entries[key] = e
+	return nil
+}
+
+func (s *MemoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
@@ -0,0 +1,19 @@
+package throttle
+
+import (
+	"context"
+	"time"
+
+	"github.com/jacobpq/soccer-manager/internal/domain/models"
+)
+
+// Store keeps failed login counters. Implementations must make
+// RecordFailure atomic so concurrent attempts are all counted.
+type Store interface {
+	Get(ctx context.Context, key string) (*models.LoginAttempt, error)
+	// RecordFailure adds a failure, restarting the count if the previous
+	// failure is older than window, and returns the updated entry.
+	RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*models.LoginAttempt, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+}
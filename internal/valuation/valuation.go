@@ -0,0 +1,153 @@
+// Package valuation prices players from their age, position, what clubs
+// last paid for them and how busy the market is for their position.
+package valuation
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+)
+
+// Formula holds the tunable parts of the valuation. Every factor multiplies
+// the player's base value, which only moves when the player is transferred.
+type Formula struct {
+	PeakAgeFrom  int
+	PeakAgeTo    int
+	YouthPremium float64 // added per year a player is younger than the peak
+	AgeDecline   float64 // removed per year a player is older than the peak
+	AgeFloor     float64 // lowest age factor
+
+	Positions map[string]float64 // factor per position, 1 when missing
+
+	PriceWeight float64 // share of the gap between base and price paid a transfer closes
+
+	DemandWeight float64 // added per recent transfer at the player's position
+	DemandCap    float64 // highest demand premium
+
+	Spread float64 // per-player noise, +/- share of the value
+}
+
+// Inputs is everything the formula looks at for one player.
+type Inputs struct {
+	PlayerID int
+	Age      int
+	Position string
+	Base     float64
+	Demand   int
+}
+
+type Model struct {
+	formula Formula
+	seed    int64
+}
+
+// NewModel builds the formula from cfg. src seeds the spread; each player's
+// share of it is fixed for the life of the model, so revaluing the same
+// inputs always gives the same value.
+func NewModel(cfg *config.Config, src rand.Source) (*Model, error) {
+	positions, err := ParsePositions(cfg.ValuationPositions)
+	if err != nil {
+		return nil, err
+	}
+
+	f := Formula{
+		PeakAgeFrom:  cfg.ValuationPeakAgeFrom,
+		PeakAgeTo:    cfg.ValuationPeakAgeTo,
+		YouthPremium: cfg.ValuationYouthPremium,
+		AgeDecline:   cfg.ValuationAgeDecline,
+		AgeFloor:     cfg.ValuationAgeFloor,
+		Positions:    positions,
+		PriceWeight:  cfg.ValuationPriceWeight,
+		DemandWeight: cfg.ValuationDemandWeight,
+		DemandCap:    cfg.ValuationDemandCap,
+		Spread:       cfg.ValuationSpread,
+	}
+	if f.PeakAgeFrom > f.PeakAgeTo {
+		return nil, fmt.Errorf("valuation peak age %d-%d is empty", f.PeakAgeFrom, f.PeakAgeTo)
+	}
+	if f.PriceWeight < 0 || f.PriceWeight > 1 {
+		return nil, fmt.Errorf("valuation price weight %v must be between 0 and 1", f.PriceWeight)
+	}
+	if f.Spread < 0 || f.Spread >= 1 {
+		return nil, fmt.Errorf("valuation spread %v must be between 0 and 1", f.Spread)
+	}
+
+	return &Model{formula: f, seed: src.Int63()}, nil
+}
+
+// ParsePositions reads a list like "GK=0.9,DF=1,MF=1.05,AT=1.15".
+func ParsePositions(s string) (map[string]float64, error) {
+	positions := make(map[string]float64)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		position, weight, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid position weight %q", part)
+		}
+		w, err := strconv.ParseFloat(weight, 64)
+		if err != nil || w <= 0 {
+			return nil, fmt.Errorf("invalid position weight %q", part)
+		}
+		positions[strings.TrimSpace(position)] = w
+	}
+	return positions, nil
+}
+
+// Rebase returns the player's base value after a transfer for price.
+func (m *Model) Rebase(base, price float64) float64 {
+	return base + m.formula.PriceWeight*(price-base)
+}
+
+// Value prices the player, rounded to whole units.
+func (m *Model) Value(in Inputs) float64 {
+	value := in.Base * m.ageFactor(in.Age) * m.positionFactor(in.Position) * m.demandFactor(in.Demand)
+
+	if m.formula.Spread > 0 {
+		value *= 1 + (m.noise(in.PlayerID)*2-1)*m.formula.Spread
+	}
+	return math.Round(value)
+}
+
+// noise is the player's point in [0, 1), derived from the seed and the
+// player alone.
+func (m *Model) noise(playerID int) float64 {
+	var b [16]byte
+	binary.LittleEndian.PutUint64(b[:8], uint64(m.seed))
+	binary.LittleEndian.PutUint64(b[8:], uint64(playerID))
+
+	h := fnv.New64a()
+	h.Write(b[:])
+	return float64(h.Sum64()>>11) / (1 << 53)
+}
+
+func (m *Model) ageFactor(age int) float64 {
+	f := m.formula
+	switch {
+	case age < f.PeakAgeFrom:
+		return 1 + float64(f.PeakAgeFrom-age)*f.YouthPremium
+	case age > f.PeakAgeTo:
+		return math.Max(f.AgeFloor, 1-float64(age-f.PeakAgeTo)*f.AgeDecline)
+	}
+	return 1
+}
+
+func (m *Model) positionFactor(position string) float64 {
+	if w, ok := m.formula.Positions[position]; ok {
+		return w
+	}
+	return 1
+}
+
+func (m *Model) demandFactor(demand int) float64 {
+	return 1 + math.Min(m.formula.DemandCap, float64(demand)*m.formula.DemandWeight)
+}
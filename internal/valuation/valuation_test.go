@@ -0,0 +1,97 @@
+package valuation
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jacobpq/soccer-manager/internal/config"
+)
+
+func newTestModel(spread float64, seed int64) *Model {
+	return &Model{
+		formula: Formula{
+			PeakAgeFrom:  24,
+			PeakAgeTo:    29,
+			YouthPremium: 0.05,
+			AgeDecline:   0.1,
+			AgeFloor:     0.4,
+			Positions:    map[string]float64{"GK": 0.8, "AT": 1.2},
+			PriceWeight:  0.5,
+			DemandWeight: 0.1,
+			DemandCap:    0.3,
+			Spread:       spread,
+		},
+		seed: seed,
+	}
+}
+
+func TestModel_Value(t *testing.T) {
+	m := newTestModel(0, 1)
+
+	tests := []struct {
+		name string
+		in   Inputs
+		want float64
+	}{
+		{"peak age, neutral position", Inputs{Age: 26, Position: "DF", Base: 1000000}, 1000000},
+		{"young attacker", Inputs{Age: 20, Position: "AT", Base: 1000000}, 1440000},
+		{"veteran goalkeeper", Inputs{Age: 32, Position: "GK", Base: 1000000}, 560000},
+		{"age floor", Inputs{Age: 40, Position: "DF", Base: 1000000}, 400000},
+		{"demand", Inputs{Age: 26, Position: "DF", Base: 1000000, Demand: 2}, 1200000},
+		{"demand cap", Inputs{Age: 26, Position: "DF", Base: 1000000, Demand: 10}, 1300000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, m.Value(tt.in))
+		})
+	}
+}
+
+func TestModel_Value_SpreadIsReproducible(t *testing.T) {
+	in := Inputs{Age: 26, Position: "DF", Base: 1000000}
+
+	a, b := newTestModel(0.1, 42), newTestModel(0.1, 42)
+	seen := make(map[float64]bool)
+	for id := 1; id <= 20; id++ {
+		in.PlayerID = id
+		va := a.Value(in)
+		assert.Equal(t, va, b.Value(in))
+		assert.Equal(t, va, a.Value(in), "revaluing must not move the value")
+		assert.InDelta(t, 1000000, va, 100000)
+		seen[va] = true
+	}
+	assert.Greater(t, len(seen), 1, "players get different shares of the spread")
+}
+
+func TestModel_Rebase(t *testing.T) {
+	m := newTestModel(0, 1)
+
+	assert.Equal(t, 1500000.0, m.Rebase(1000000, 2000000))
+	assert.Equal(t, 750000.0, m.Rebase(1000000, 500000))
+}
+
+func TestNewModel(t *testing.T) {
+	cfg := &config.Config{
+		ValuationPeakAgeFrom: 24,
+		ValuationPeakAgeTo:   29,
+		ValuationPositions:   "GK=0.9, AT=1.15",
+		ValuationPriceWeight: 0.5,
+		ValuationSpread:      0.05,
+	}
+
+	m, err := NewModel(cfg, rand.NewSource(1))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"GK": 0.9, "AT": 1.15}, m.formula.Positions)
+
+	cfg.ValuationPositions = "GK:0.9"
+	_, err = NewModel(cfg, rand.NewSource(1))
+	assert.Error(t, err)
+
+	cfg.ValuationPositions = ""
+	cfg.ValuationPriceWeight = 2
+	_, err = NewModel(cfg, rand.NewSource(1))
+	assert.Error(t, err)
+}
@@ -1,32 +1,211 @@
 CREATE TABLE users (
     id SERIAL PRIMARY KEY,
     email VARCHAR(255) UNIQUE NOT NULL,
-    password_hash VARCHAR(255) NOT NULL
+    password_hash VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'manager' CHECK (role IN ('manager', 'moderator', 'admin')),
+    verified_at TIMESTAMP,
+    banned_at TIMESTAMP,
+    totp_secret VARCHAR(64),
+    totp_enabled_at TIMESTAMP,
+    totp_last_step BIGINT
+);
+CREATE TABLE recovery_codes (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP,
+    UNIQUE (user_id, code_hash)
 );
 DROP TABLE IF EXISTS sessions;
 CREATE TABLE sessions (
     id SERIAL PRIMARY KEY,
     user_id INT REFERENCES users(id) ON DELETE CASCADE,
-    refresh_token VARCHAR(255) UNIQUE NOT NULL,
+    refresh_token VARCHAR(512) UNIQUE NOT NULL,
     refresh_expires_at TIMESTAMP NOT NULL,
+    user_agent TEXT,
+    ip_address VARCHAR(45),
+    last_refreshed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE rotated_refresh_tokens (
+    refresh_token VARCHAR(512) PRIMARY KEY,
+    session_id INT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    rotated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE password_reset_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
+CREATE TABLE login_attempts (
+    key VARCHAR(320) PRIMARY KEY,
+    failures INT NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP
+);
+CREATE TABLE idempotency_keys (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    key VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    status_code INT,
+    content_type VARCHAR(255),
+    response_body BYTEA,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
 CREATE TABLE teams (
     id SERIAL PRIMARY KEY,
     user_id INT UNIQUE REFERENCES users(id),
     name VARCHAR(255) UNIQUE NOT NULL,
     country VARCHAR(100) NOT NULL,
-    budget DECIMAL(15, 2) DEFAULT 5000000
+    budget DECIMAL(15, 2) DEFAULT 5000000 CHECK (budget >= 0),
+    ai_strategy VARCHAR(50)
 );
 CREATE TABLE players (
     id SERIAL PRIMARY KEY,
-    team_id INT REFERENCES teams(id),
+    team_id INT REFERENCES teams(id) ON DELETE SET NULL,
     first_name VARCHAR(100),
     last_name VARCHAR(100),
     country VARCHAR(100),
     age INT,
     position VARCHAR(50),
     value DECIMAL(15, 2) DEFAULT 1000000,
+    base_value DECIMAL(15, 2) DEFAULT 1000000,
     market_value DECIMAL(15, 2),
-    on_transfer_list BOOLEAN DEFAULT FALSE
-);
\ No newline at end of file
+    on_transfer_list BOOLEAN DEFAULT FALSE,
+    release_clause DECIMAL(15, 2) CHECK (release_clause > 0),
+    listed_at TIMESTAMP,
+    listing_expires_at TIMESTAMP,
+    delisted_at TIMESTAMP
+);
+CREATE INDEX idx_players_team_id ON players(team_id);
+CREATE INDEX idx_players_market_price ON players(market_value, id) WHERE on_transfer_list;
+CREATE INDEX idx_players_market_value ON players(value, id) WHERE on_transfer_list;
+CREATE INDEX idx_players_market_age ON players(age, id) WHERE on_transfer_list;
+CREATE INDEX idx_players_market_listed_at ON players(listed_at, id) WHERE on_transfer_list;
+CREATE INDEX idx_players_market_position ON players(position) WHERE on_transfer_list;
+CREATE INDEX idx_players_market_country ON players(country) WHERE on_transfer_list;
+CREATE INDEX idx_players_listing_expires_at ON players(listing_expires_at) WHERE on_transfer_list;
+CREATE TABLE auctions (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    seller_team_id INT REFERENCES teams(id) ON DELETE SET NULL,
+    reserve_price DECIMAL(15, 2) NOT NULL,
+    min_increment DECIMAL(15, 2) NOT NULL,
+    high_bid DECIMAL(15, 2),
+    high_bidder_team_id INT REFERENCES teams(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'sold', 'unsold', 'cancelled')),
+    ends_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX idx_auctions_open_player ON auctions(player_id) WHERE status = 'open';
+CREATE INDEX idx_auctions_open_ends_at ON auctions(ends_at) WHERE status = 'open';
+CREATE TABLE bids (
+    id SERIAL PRIMARY KEY,
+    auction_id INT NOT NULL REFERENCES auctions(id) ON DELETE CASCADE,
+    team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    amount DECIMAL(15, 2) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_bids_auction_id ON bids(auction_id);
+CREATE TABLE transfer_offers (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    buyer_team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    seller_team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    proposed_by_team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    parent_offer_id INT REFERENCES transfer_offers(id) ON DELETE SET NULL,
+    amount DECIMAL(15, 2) NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'rejected', 'countered', 'expired', 'cancelled')),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMP
+);
+CREATE UNIQUE INDEX idx_transfer_offers_pending ON transfer_offers(player_id, buyer_team_id) WHERE status = 'pending';
+CREATE INDEX idx_transfer_offers_buyer ON transfer_offers(buyer_team_id, status);
+CREATE INDEX idx_transfer_offers_seller ON transfer_offers(seller_team_id, status);
+CREATE TABLE transfers (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    seller_team_id INT REFERENCES teams(id) ON DELETE SET NULL,
+    buyer_team_id INT REFERENCES teams(id) ON DELETE SET NULL,
+    price DECIMAL(15, 2) NOT NULL,
+    value_before DECIMAL(15, 2) NOT NULL,
+    value_after DECIMAL(15, 2) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_transfers_player_id ON transfers(player_id, created_at DESC);
+CREATE INDEX idx_transfers_seller_team_id ON transfers(seller_team_id, created_at DESC);
+CREATE INDEX idx_transfers_buyer_team_id ON transfers(buyer_team_id, created_at DESC);
+CREATE INDEX idx_transfers_created_at ON transfers(created_at DESC);
+CREATE TABLE transfer_windows (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    season_key VARCHAR(100) UNIQUE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL CHECK (ends_at >= starts_at),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_transfer_windows_ends_at ON transfer_windows(ends_at);
+CREATE TABLE loans (
+    id SERIAL PRIMARY KEY,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    lender_team_id INT REFERENCES teams(id) ON DELETE SET NULL,
+    borrower_team_id INT REFERENCES teams(id) ON DELETE SET NULL,
+    fee DECIMAL(15, 2) NOT NULL CHECK (fee >= 0),
+    days INT NOT NULL CHECK (days > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMP NOT NULL,
+    starts_at TIMESTAMP,
+    ends_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMP,
+    returned_at TIMESTAMP
+);
+CREATE UNIQUE INDEX idx_loans_pending ON loans(player_id, borrower_team_id) WHERE status = 'pending';
+CREATE UNIQUE INDEX idx_loans_active ON loans(player_id) WHERE status = 'active';
+CREATE INDEX idx_loans_ends_at ON loans(ends_at) WHERE status = 'active';
+CREATE INDEX idx_loans_lender ON loans(lender_team_id, status);
+CREATE INDEX idx_loans_borrower ON loans(borrower_team_id, status);
+CREATE TABLE swaps (
+    id SERIAL PRIMARY KEY,
+    proposer_team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    recipient_team_id INT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    cash DECIMAL(15, 2) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMP
+);
+CREATE INDEX idx_swaps_proposer ON swaps(proposer_team_id, status);
+CREATE INDEX idx_swaps_recipient ON swaps(recipient_team_id, status);
+CREATE TABLE swap_players (
+    swap_id INT NOT NULL REFERENCES swaps(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    from_team_id INT NOT NULL,
+    PRIMARY KEY (swap_id, player_id)
+);
+CREATE TABLE watchlist (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, player_id)
+);
+CREATE INDEX idx_watchlist_player_id ON watchlist(player_id);
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    player_id INT NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    price DECIMAL(15, 2),
+    old_price DECIMAL(15, 2),
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, created_at DESC);
\ No newline at end of file